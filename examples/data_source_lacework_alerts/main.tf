@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_alerts" "example" {
+  start_time = var.start_time
+  end_time   = var.end_time
+  severities = var.severities
+  statuses   = var.statuses
+}
+
+variable "start_time" {
+  type    = string
+  default = "-7d"
+}
+
+variable "end_time" {
+  type    = string
+  default = "now"
+}
+
+variable "severities" {
+  type    = list(string)
+  default = ["Critical", "High"]
+}
+
+variable "statuses" {
+  type    = list(string)
+  default = ["Open"]
+}
+
+output "ids" {
+  value = data.lacework_alerts.example.ids
+}
+
+output "statuses" {
+  value = distinct([for a in data.lacework_alerts.example.alerts : a.status])
+}
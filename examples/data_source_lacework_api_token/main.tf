@@ -8,9 +8,15 @@ terraform {
 
 provider "lacework" {}
 
-data "lacework_api_token" "test" {}
+data "lacework_api_token" "test" {
+  expiry_seconds = 600
+}
 
 output "lacework_api_token" {
   value     = data.lacework_api_token.test.token
   sensitive = true
 }
+
+output "lacework_api_token_expires_at" {
+  value = data.lacework_api_token.test.expires_at
+}
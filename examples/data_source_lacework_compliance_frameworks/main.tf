@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_compliance_frameworks" "example" {
+  cloud = var.cloud
+}
+
+variable "cloud" {
+  type    = string
+  default = "AWS"
+}
+
+output "names" {
+  value = [for f in data.lacework_compliance_frameworks.example.frameworks : f.name]
+}
+
+output "domains" {
+  value = distinct(flatten([for f in data.lacework_compliance_frameworks.example.frameworks : f.domains]))
+}
+
+output "policy_ids" {
+  value = data.lacework_compliance_frameworks.example.policy_ids
+}
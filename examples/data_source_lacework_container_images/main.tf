@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_container_images" "all" {
+  start_time = "-7d"
+}
+
+output "count" {
+  value = length(data.lacework_container_images.all.images)
+}
+
+output "image_ids" {
+  value = data.lacework_container_images.all.image_ids
+}
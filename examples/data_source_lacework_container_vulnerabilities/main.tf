@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_container_vulnerabilities" "critical" {
+  start_time = "-24h"
+
+  filter {
+    field      = "severity"
+    expression = "eq"
+    value      = var.severity
+  }
+}
+
+variable "severity" {
+  type    = string
+  default = "Critical"
+}
+
+output "severities" {
+  value = distinct([for vuln in data.lacework_container_vulnerabilities.critical.vulnerabilities : vuln.severity])
+}
+
+output "vuln_ids" {
+  value = data.lacework_container_vulnerabilities.critical.vuln_ids
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_containers" "all" {
+  start_time = "-7d"
+}
+
+output "count" {
+  value = length(data.lacework_containers.all.containers)
+}
+
+output "image_ids" {
+  value = data.lacework_containers.all.image_ids
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_image_vulnerability_summaries" "registry" {
+  start_time = "-7d"
+  registries = [var.registry]
+}
+
+variable "registry" {
+  type    = string
+  default = "index.docker.io"
+}
+
+output "registries" {
+  value = distinct([for image in data.lacework_image_vulnerability_summaries.registry.images : image.registry])
+}
+
+output "critical_count" {
+  value = data.lacework_image_vulnerability_summaries.registry.critical_count
+}
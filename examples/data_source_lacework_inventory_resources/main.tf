@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_inventory_resources" "buckets" {
+  cloud          = "AWS"
+  resource_types = [var.resource_type]
+}
+
+variable "resource_type" {
+  type    = string
+  default = "s3:bucket"
+}
+
+output "resource_types" {
+  value = distinct([for resource in data.lacework_inventory_resources.buckets.resources : resource.resource_type])
+}
+
+output "urns" {
+  value = data.lacework_inventory_resources.buckets.urns
+}
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_lql_datasources" "example" {
+  name_prefix = var.name_prefix
+}
+
+data "lacework_lql_datasource" "example" {
+  name = var.datasource
+}
+
+variable "name_prefix" {
+  type    = string
+  default = "LW_CFG_AWS_"
+}
+
+variable "datasource" {
+  type    = string
+  default = "LW_CFG_AWS_S3"
+}
+
+output "names" {
+  value = data.lacework_lql_datasources.example.names
+}
+
+output "field_names" {
+  value = data.lacework_lql_datasource.example.field_names
+}
+
+output "resource_id_type" {
+  value = data.lacework_lql_datasource.example.field_types["RESOURCE_ID"]
+}
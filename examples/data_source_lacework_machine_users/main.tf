@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_machine_users" "all" {
+  start_time = "-7d"
+}
+
+output "count" {
+  value = length(data.lacework_machine_users.all.users)
+}
+
+output "usernames" {
+  value = data.lacework_machine_users.all.usernames
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_machines" "all" {
+  start_time = "-7d"
+}
+
+output "count" {
+  value = length(data.lacework_machines.all.machines)
+}
+
+output "hostnames" {
+  value = data.lacework_machines.all.hostnames
+}
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_policies" "example" {
+  id_prefix   = var.id_prefix
+  severities  = var.severities
+  policy_type = var.policy_type
+}
+
+variable "id_prefix" {
+  type    = string
+  default = "lacework-global-"
+}
+
+variable "severities" {
+  type    = list(string)
+  default = ["critical", "high"]
+}
+
+variable "policy_type" {
+  type    = string
+  default = "Compliance"
+}
+
+output "ids" {
+  value = data.lacework_policies.example.ids
+}
+
+output "severities" {
+  value = distinct([for p in data.lacework_policies.example.policies : p.severity])
+}
@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_query_result" "example" {
+  query      = <<-EOT
+  {
+      source {
+          CloudTrailRawEvents
+      }
+      return distinct {
+          INSERT_ID,
+          EVENT_NAME
+      }
+  }
+  EOT
+  start_time = var.start_time
+  end_time   = "now"
+  limit      = var.limit
+}
+
+variable "start_time" {
+  type    = string
+  default = "-7d"
+}
+
+variable "limit" {
+  type    = number
+  default = 5
+}
+
+output "row_count" {
+  value = data.lacework_query_result.example.row_count
+}
+
+output "event_names" {
+  value = [for row in data.lacework_query_result.example.rows : row["EVENT_NAME"]]
+}
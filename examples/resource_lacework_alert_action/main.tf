@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+data "lacework_alerts" "known_false_positives" {
+  start_time  = "-7d"
+  statuses    = ["Open"]
+  alert_types = var.alert_types
+}
+
+resource "lacework_alert_action" "close" {
+  for_each = toset([for id in data.lacework_alerts.known_false_positives.ids : tostring(id)])
+  alert_id = tonumber(each.value)
+
+  comments = ["Triaged as a known false positive, see the security review"]
+
+  close {
+    reason  = "False positive"
+    comment = "Closed by Terraform"
+  }
+}
+
+variable "alert_types" {
+  type    = list(string)
+  default = ["NewExternalServerDNSConn"]
+}
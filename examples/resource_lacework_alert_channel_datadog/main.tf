@@ -41,11 +41,6 @@ resource "lacework_alert_channel_datadog" "example" {
   test_integration = false
 }
 
-output "api_key" {
-  value = lacework_alert_channel_datadog.example.api_key
-  sensitive = true
-}
-
 output "datadog_site" {
   value = lacework_alert_channel_datadog.example.datadog_site
 }
@@ -111,9 +111,4 @@ output "custom_template_file" {
 
 output "username" {
   value = lacework_alert_channel_jira_cloud.example.username
-}
-
-output "api_token" {
-  value     = lacework_alert_channel_jira_cloud.example.api_token
-  sensitive = true
 }
\ No newline at end of file
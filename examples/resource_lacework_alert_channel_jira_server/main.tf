@@ -111,9 +111,4 @@ output "custom_template_file" {
 
 output "username" {
   value = lacework_alert_channel_jira_server.example.username
-}
-
-output "password" {
-  value     = lacework_alert_channel_jira_server.example.password
-  sensitive = true
 }
\ No newline at end of file
@@ -38,8 +38,4 @@ output "channel_name" {
 
 output "account_id" {
   value = lacework_alert_channel_newrelic.example.account_id
-}
-
-output "insert_key" {
-  value = lacework_alert_channel_newrelic.example.insert_key
 }
\ No newline at end of file
@@ -68,10 +68,6 @@ output "channel_name" {
   value = lacework_alert_channel_splunk.example.name
 }
 
-output "hec_token" {
-  value = lacework_alert_channel_splunk.example.hec_token
-}
-
 output "channel" {
   value = lacework_alert_channel_splunk.example.channel
 }
@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+resource "lacework_container_image_assessment" "example" {
+  registry   = var.registry
+  repository = var.repository
+  tag        = var.tag
+
+  max_critical = var.max_critical
+}
+
+variable "registry" {
+  type    = string
+  default = "index.docker.io"
+}
+
+variable "repository" {
+  type    = string
+  default = "lacework/lacework-cli"
+}
+
+variable "tag" {
+  type    = string
+  default = "latest"
+}
+
+variable "max_critical" {
+  type    = number
+  default = -1
+}
+
+output "eval_guid" {
+  value = lacework_container_image_assessment.example.eval_guid
+}
+
+output "digest" {
+  value = lacework_container_image_assessment.example.digest
+}
+
+output "critical_count" {
+  value = lacework_container_image_assessment.example.critical_count
+}
+
+output "total_count" {
+  value = lacework_container_image_assessment.example.total_count
+}
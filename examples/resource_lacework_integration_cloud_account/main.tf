@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+resource "lacework_integration_cloud_account" "example" {
+  name = var.integration_name
+  type = "GcpCfg"
+  data = jsonencode({
+    id     = "techally-test"
+    idType = "PROJECT"
+    credentials = {
+      clientId     = var.client_id
+      clientEmail  = var.client_email
+      privateKeyId = var.private_key_id
+      privateKey   = var.private_key
+    }
+  })
+  sensitive_keys = ["privateKeyId"]
+  retries        = 10
+}
+
+variable "integration_name" {
+  type    = string
+  default = "Generic Cloud Account Example"
+}
+variable "client_id" {
+  type      = string
+  sensitive = true
+}
+variable "client_email" {
+  type      = string
+  sensitive = true
+}
+variable "private_key_id" {
+  type      = string
+  sensitive = true
+}
+variable "private_key" {
+  type      = string
+  sensitive = true
+}
+
+output "type_name" {
+  value = lacework_integration_cloud_account.example.type_name
+}
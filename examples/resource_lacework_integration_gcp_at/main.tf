@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+resource "lacework_integration_gcp_at" "example" {
+  name = var.integration_name
+  credentials {
+    client_id      = var.client_id
+    client_email   = var.client_email
+    private_key_id = var.private_key_id
+    private_key    = var.private_key
+  }
+  resource_level = "PROJECT"
+  resource_id    = "techally-test"
+  subscription   = var.subscription
+  retries        = 10
+}
+
+
+variable "integration_name" {
+  type    = string
+  default = "Google Audit Trail Example"
+}
+variable "client_id" {
+  type      = string
+  sensitive = true
+}
+variable "client_email" {
+  type      = string
+  sensitive = true
+}
+variable "private_key_id" {
+  type      = string
+  sensitive = true
+}
+variable "private_key" {
+  type      = string
+  sensitive = true
+}
+variable "subscription" {
+  type    = string
+  default = "projects/techally-test/subscriptions/lacework-audit-trail"
+}
+
+output "subscription" {
+  value = lacework_integration_gcp_at.example.subscription
+}
@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+resource "lacework_inventory_scan" "aws" {
+  cloud = "AWS"
+
+  triggers = {
+    scan = var.scan
+  }
+}
+
+variable "scan" {
+  type    = string
+  default = "1"
+}
+
+output "scan_time" {
+  value = lacework_inventory_scan.aws.scan_time
+}
+
+output "status" {
+  value = lacework_inventory_scan.aws.status
+}
@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+resource "lacework_managed_policies" "example" {
+  authoritative = true
+
+  selector {
+    id_prefix = var.selector_id_prefix
+  }
+
+  policy {
+    id       = var.id
+    enabled  = var.enabled
+    severity = var.severity
+  }
+}
+
+variable "selector_id_prefix" {
+  type    = string
+  default = "lacework-global-2"
+}
+
+variable "id" {
+  type    = string
+  default = "lacework-global-1"
+}
+
+variable "enabled" {
+  type    = bool
+}
+
+variable "severity" {
+  type    = string
+}
+
+output "baseline" {
+  value = lacework_managed_policies.example.baseline
+}
+
+output "drifted_policies" {
+  value = lacework_managed_policies.example.drifted_policies
+}
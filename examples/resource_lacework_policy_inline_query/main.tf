@@ -0,0 +1,62 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+resource "lacework_policy" "example" {
+  title       = var.title
+  severity    = "High"
+  type        = "Violation"
+  description = "Policy with an inline query created via Terraform"
+  remediation = "Please Investigate"
+  evaluation  = "Hourly"
+  enabled     = false
+
+  query {
+    id   = var.query_id
+    text = <<-EOT
+    {
+        source {
+            CloudTrailRawEvents
+        }
+        filter {
+            EVENT_SOURCE = 'signin.amazonaws.com'
+            and EVENT_NAME in ('ConsoleLogin')
+            and EVENT:additionalEventData.MFAUsed::String = 'No'
+        }
+        return distinct {
+            INSERT_ID,
+            INSERT_TIME,
+            EVENT_TIME,
+            EVENT
+        }
+    }
+    EOT
+  }
+
+  alerting {
+    enabled = false
+    profile = "LW_CloudTrail_Alerts"
+  }
+}
+
+variable "title" {
+  type    = string
+  default = "lql-terraform-policy-inline-query"
+}
+
+variable "query_id" {
+  type    = string
+  default = "Lql_Terraform_Policy_Inline_Query"
+}
+
+output "title" {
+  value = lacework_policy.example.title
+}
+
+output "query_id" {
+  value = lacework_policy.example.query_id
+}
@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+resource "lacework_report_definition" "example" {
+  name         = var.name
+  display_name = var.display_name
+  sub_type     = "AWS"
+
+  section {
+    title    = var.section_title
+    category = "Identity and Access Management"
+    policies = var.policies
+  }
+}
+
+variable "name" {
+  type    = string
+  default = "Terraform Test Report Definition"
+}
+
+variable "display_name" {
+  type    = string
+  default = "Terraform Test Report"
+}
+
+variable "section_title" {
+  type    = string
+  default = "IAM"
+}
+
+variable "policies" {
+  type    = list(string)
+  default = ["lacework-global-31", "lacework-global-32"]
+}
+
+output "name" {
+  value = lacework_report_definition.example.name
+}
+
+output "version" {
+  value = lacework_report_definition.example.version
+}
+
+output "section_title" {
+  value = lacework_report_definition.example.section[0].title
+}
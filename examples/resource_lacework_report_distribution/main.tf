@@ -0,0 +1,68 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+resource "lacework_alert_channel_email" "example" {
+  name       = "Terraform Test Report Distribution Channel"
+  recipients = ["foo@example.com"]
+
+  // test_integration input is used in this example only for testing
+  // purposes, it help us avoid sending a "test" request to the
+  // system we are integrating to. In production, this should remain
+  // turned on ("true") which is the default setting
+  test_integration = false
+}
+
+resource "lacework_report_definition" "example" {
+  name         = "Terraform Test Report Distribution Definition"
+  display_name = "Terraform Test Report Distribution Definition"
+  sub_type     = "AWS"
+
+  section {
+    title    = "IAM"
+    policies = ["lacework-global-31"]
+  }
+}
+
+resource "lacework_report_distribution" "example" {
+  name                   = var.name
+  report_definition_guid = lacework_report_definition.example.id
+  frequency              = var.frequency
+  alert_channels         = [lacework_alert_channel_email.example.intg_guid]
+  scope                  = "Cloud Account Integration"
+  severities             = ["Critical", "High"]
+  violations             = ["NonCompliant"]
+
+  integrations {
+    account_id = var.account_id
+  }
+}
+
+variable "name" {
+  type    = string
+  default = "Terraform Test Report Distribution"
+}
+
+variable "frequency" {
+  type    = string
+  default = "daily"
+}
+
+variable "account_id" {
+  type    = string
+  default = "123456789012"
+}
+
+output "id" {
+  value = lacework_report_distribution.example.id
+}
+
+output "frequency" {
+  value = lacework_report_distribution.example.frequency
+}
@@ -0,0 +1,70 @@
+terraform {
+  required_providers {
+    lacework = {
+      source = "lacework/lacework"
+    }
+  }
+}
+
+provider "lacework" {}
+
+resource "lacework_resource_group" "example" {
+  type        = "AWS"
+  name        = var.resource_group_name
+  description = var.description
+
+  expression_json = jsonencode({
+    filters = {
+      region = { field = "Region", operation = "EQUALS", values = ["us-east-1"] }
+      prod   = { field = "Resource Tag", operation = "EQUALS", key = "env", values = ["prod"] }
+      team   = { field = "Resource Tag", operation = "EQUALS", key = "team", values = ["security"] }
+      acct1  = { field = "Account", operation = "EQUALS", values = ["987654321"] }
+      acct2  = { field = "Account", operation = "EQUALS", values = ["123456789"] }
+    }
+    expression = {
+      operator = "AND"
+      children = [
+        { filterName = "region" },
+        {
+          operator = "OR"
+          children = [
+            { filterName = "prod" },
+            {
+              operator = "AND"
+              children = [
+                { filterName = "team" },
+                {
+                  operator = "OR"
+                  children = [
+                    { filterName = "acct1" },
+                    {
+                      operator = "AND"
+                      children = [{ filterName = "acct2" }]
+                    }
+                  ]
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  })
+}
+
+variable "resource_group_name" {
+  type    = string
+  default = "Terraform Test Aws Resource Group Expression JSON"
+}
+variable "description" {
+  type    = string
+  default = "Terraform Test RGv2 Expression JSON"
+}
+
+output "expression_json" {
+  value = lacework_resource_group.example.expression_json
+}
+
+output "id" {
+  value = lacework_resource_group.example.id
+}
@@ -0,0 +1,31 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAlertsDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_alerts'
+func TestAlertsDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_alerts",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	statuses := terraform.OutputList(t, terraformOptions, "statuses")
+	assert.Subset(t, []string{"Open"}, statuses)
+
+	// an invalid time window must fail
+	terraformOptions.Vars = map[string]interface{}{
+		"start_time": "now",
+		"end_time":   "-1h",
+	}
+	_, err := terraform.ApplyE(t, terraformOptions)
+	assert.ErrorContains(t, err, "start_time must be before end_time")
+}
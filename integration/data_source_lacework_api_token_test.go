@@ -2,6 +2,7 @@ package integration
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terratest/modules/terraform"
 	"github.com/stretchr/testify/assert"
@@ -19,4 +20,9 @@ func TestApiTokenDataSource(t *testing.T) {
 	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
 	apiToken := terraform.Output(t, terraformOptions, "lacework_api_token")
 	assert.NotEmpty(t, apiToken)
+
+	expiresAt, err := time.Parse(time.RFC3339, terraform.Output(t, terraformOptions, "lacework_api_token_expires_at"))
+	if assert.NoError(t, err) {
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), expiresAt, 5*time.Minute)
+	}
 }
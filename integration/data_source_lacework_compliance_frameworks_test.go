@@ -0,0 +1,24 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestComplianceFrameworksDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_compliance_frameworks'
+func TestComplianceFrameworksDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_compliance_frameworks",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	assert.NotEmpty(t, terraform.OutputList(t, terraformOptions, "names"))
+	assert.NotEmpty(t, terraform.OutputList(t, terraformOptions, "policy_ids"))
+	assert.Contains(t, terraform.OutputList(t, terraformOptions, "domains"), "AWS")
+}
@@ -0,0 +1,23 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHostVulnerabilitiesDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_host_vulnerabilities'
+func TestHostVulnerabilitiesDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_host_vulnerabilities",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	severities := terraform.OutputList(t, terraformOptions, "severities")
+	assert.Subset(t, []string{"Critical"}, severities)
+}
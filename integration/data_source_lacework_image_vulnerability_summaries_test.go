@@ -0,0 +1,23 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestImageVulnerabilitySummariesDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_image_vulnerability_summaries'
+func TestImageVulnerabilitySummariesDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_image_vulnerability_summaries",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	registries := terraform.OutputList(t, terraformOptions, "registries")
+	assert.Subset(t, []string{"index.docker.io"}, registries)
+}
@@ -0,0 +1,23 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestInventoryResourcesDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_inventory_resources'
+func TestInventoryResourcesDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_inventory_resources",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	resourceTypes := terraform.OutputList(t, terraformOptions, "resource_types")
+	assert.Subset(t, []string{"s3:bucket"}, resourceTypes)
+}
@@ -0,0 +1,31 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLqlDatasourcesDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_lql_datasources'
+func TestLqlDatasourcesDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_lql_datasources",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	names := terraform.OutputList(t, terraformOptions, "names")
+	if assert.Contains(t, names, "LW_CFG_AWS_S3") {
+		for _, name := range names {
+			assert.True(t, strings.HasPrefix(name, "LW_CFG_AWS_"), "unexpected datasource %s", name)
+		}
+	}
+
+	assert.Contains(t, terraform.OutputList(t, terraformOptions, "field_names"), "RESOURCE_ID")
+	assert.Equal(t, "String", terraform.Output(t, terraformOptions, "resource_id_type"))
+}
@@ -0,0 +1,26 @@
+package integration
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMachineUsersDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_machine_users'
+func TestMachineUsersDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_machine_users",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	count, err := strconv.Atoi(terraform.Output(t, terraformOptions, "count"))
+	if assert.NoError(t, err) {
+		assert.LessOrEqual(t, len(terraform.OutputList(t, terraformOptions, "usernames")), count)
+	}
+}
@@ -0,0 +1,26 @@
+package integration
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMachinesDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_machines'
+func TestMachinesDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_machines",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	count, err := strconv.Atoi(terraform.Output(t, terraformOptions, "count"))
+	if assert.NoError(t, err) {
+		assert.LessOrEqual(t, len(terraform.OutputList(t, terraformOptions, "hostnames")), count)
+	}
+}
@@ -0,0 +1,31 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPoliciesDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_policies'
+func TestPoliciesDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_policies",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	ids := terraform.OutputList(t, terraformOptions, "ids")
+	if assert.NotEmpty(t, ids) {
+		for _, id := range ids {
+			assert.True(t, strings.HasPrefix(id, "lacework-global-"), "unexpected policy id %s", id)
+		}
+	}
+
+	severities := terraform.OutputList(t, terraformOptions, "severities")
+	assert.Subset(t, []string{"critical", "high"}, severities)
+}
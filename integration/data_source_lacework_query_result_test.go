@@ -0,0 +1,30 @@
+package integration
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestQueryResultDataSource uses the Terraform plan at:
+// => '../examples/data_source_lacework_query_result'
+func TestQueryResultDataSource(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/data_source_lacework_query_result",
+		EnvVars:      tokenEnvVar,
+		Vars: map[string]interface{}{
+			"limit": 5,
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	rowCount, err := strconv.Atoi(terraform.Output(t, terraformOptions, "row_count"))
+	if assert.NoError(t, err) {
+		assert.LessOrEqual(t, rowCount, 5)
+		assert.Len(t, terraform.OutputList(t, terraformOptions, "event_names"), rowCount)
+	}
+}
@@ -186,6 +186,18 @@ func GetGcpCfgIntegration(result string) api.GcpCfgIntegrationResponse {
 	return res
 }
 
+func GetGcpAtSesIntegration(result string) api.GcpAtSesIntegrationResponse {
+	id := GetIDFromTerraResults(result)
+
+	res, err := LwClient.V2.CloudAccounts.GetGcpAtSes(id)
+
+	if err != nil {
+		log.Fatalf("Unable to find integration id: %s\n Response: %v", id, res)
+	}
+
+	return res
+}
+
 func GetContainerRegisteryGar(result string) api.GcpGarIntegrationResponse {
 	id := GetIDFromTerraResults(result)
 
@@ -373,6 +385,24 @@ func GetReportRuleProps(result string) api.ReportRuleResponse {
 	return data
 }
 
+func GetReportDefinitionProps(result string) api.ReportDefinitionResponse {
+	id := GetIDFromTerraResults(result)
+
+	resp, err := LwClient.V2.ReportDefinitions.Get(id)
+	if err != nil {
+		log.Fatalf("Unable to retrieve report definition with id: %s", id)
+	}
+	return resp
+}
+
+func GetReportDistributionProps(id string) api.ReportDistributionResponse {
+	resp, err := LwClient.V2.ReportDistributions.Get(id)
+	if err != nil {
+		log.Fatalf("Unable to retrieve report distribution with id: %s", id)
+	}
+	return resp
+}
+
 // GetSpecificIDFromTerraResults returns the specific index id found in the Terraform output
 func GetSpecificIDFromTerraResults(i int, result string) string {
 	re := regexp.MustCompile(`\[id=(.*?)\]`)
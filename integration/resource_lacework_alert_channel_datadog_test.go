@@ -49,9 +49,7 @@ func TestDatadogAlertChannelCreate(t *testing.T) {
 	// Verify that the terraform resource has the correct information as expected
 	actualDatadogSite := terraform.Output(t, terraformOptions, "datadog_site")
 	actualDatadogService := terraform.Output(t, terraformOptions, "datadog_service")
-	actualApiKey := terraform.Output(t, terraformOptions, "api_key")
 	assert.Equal(t, "Datadog Alert Channel Updated", GetAlertChannelName(update))
 	assert.Equal(t, "com", actualDatadogSite)
 	assert.Equal(t, "Logs Detail", actualDatadogService)
-	assert.Equal(t, apiKey, actualApiKey)
 }
@@ -69,7 +69,6 @@ func TestAlertChannelJiraCloudCreate(t *testing.T) {
 		actualIssueType := terraform.Output(t, terraformOptions, "issue_type")
 		actualProjectKey := terraform.Output(t, terraformOptions, "project_key")
 		actualUsername := terraform.Output(t, terraformOptions, "username")
-		actualApiToken := terraform.Output(t, terraformOptions, "api_token")
 		actualConfiguration := terraform.Output(t, terraformOptions, "configuration")
 		actualIssueGrouping := terraform.Output(t, terraformOptions, "group_issues_by")
 		actualCustomTemplateFile := terraform.Output(t, terraformOptions, "custom_template_file")
@@ -82,6 +81,5 @@ func TestAlertChannelJiraCloudCreate(t *testing.T) {
 		assert.Equal(t, data["bidirectionalConfig"], actualConfiguration)
 		assert.Equal(t, data["issueGrouping"], actualIssueGrouping)
 		assert.Equal(t, customTemplateFile, actualCustomTemplateFile)
-		assert.Equal(t, "fake-api-token-updated", actualApiToken)
 	}
 }
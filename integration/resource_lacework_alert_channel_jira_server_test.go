@@ -69,7 +69,6 @@ func TestAlertChannelJiraServerCreate(t *testing.T) {
 		actualIssueType := terraform.Output(t, terraformOptions, "issue_type")
 		actualProjectKey := terraform.Output(t, terraformOptions, "project_key")
 		actualUsername := terraform.Output(t, terraformOptions, "username")
-		actualPassword := terraform.Output(t, terraformOptions, "password")
 		actualConfiguration := terraform.Output(t, terraformOptions, "configuration")
 		actualIssueGrouping := terraform.Output(t, terraformOptions, "group_issues_by")
 		actualCustomTemplateFile := terraform.Output(t, terraformOptions, "custom_template_file")
@@ -79,7 +78,6 @@ func TestAlertChannelJiraServerCreate(t *testing.T) {
 		assert.Equal(t, data["jiraUrl"], actualJiraUrl)
 		assert.Equal(t, data["projectId"], actualProjectKey)
 		assert.Equal(t, data["username"], actualUsername)
-		assert.Equal(t, "fake-password-updated", actualPassword)
 		assert.Equal(t, data["bidirectionalConfig"], actualConfiguration)
 		assert.Equal(t, data["issueGrouping"], actualIssueGrouping)
 		assert.Equal(t, customTemplateFile, actualCustomTemplateFile)
@@ -0,0 +1,37 @@
+package integration
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestContainerImageAssessment applies integration terraform:
+// => '../examples/resource_lacework_container_image_assessment'
+//
+// It assesses a public image without thresholds, then verifies that lowering
+// the critical threshold below the number of critical vulnerabilities fails
+func TestContainerImageAssessment(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_container_image_assessment",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	assert.NotEmpty(t, terraform.Output(t, terraformOptions, "eval_guid"))
+	assert.NotEmpty(t, terraform.Output(t, terraformOptions, "digest"))
+
+	critical, err := strconv.Atoi(terraform.Output(t, terraformOptions, "critical_count"))
+	if !assert.NoError(t, err) || critical == 0 {
+		return
+	}
+
+	terraformOptions.Vars = map[string]interface{}{"max_critical": critical - 1}
+	_, err = terraform.ApplyE(t, terraformOptions)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "exceeds the vulnerability thresholds")
+	}
+}
@@ -0,0 +1,49 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIntegrationCloudAccountCreate applies integration terraform:
+// => '../examples/resource_lacework_integration_cloud_account'
+//
+// It uses the go-sdk to verify the created integration,
+// applies an update with new integration name and destroys it
+func TestIntegrationCloudAccountCreate(t *testing.T) {
+	gcreds, err := googleLoadDefaultCredentials()
+	if err != nil {
+		t.Skip("this test requires you to set GOOGLE_CREDENTIALS environment variable")
+	}
+
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_integration_cloud_account",
+		Vars: map[string]interface{}{
+			"client_id":      gcreds.ClientID,
+			"client_email":   gcreds.ClientEmail,
+			"private_key_id": gcreds.PrivateKeyID,
+		},
+		EnvVars: map[string]string{
+			"TF_VAR_private_key": gcreds.PrivateKey,
+			"LW_API_TOKEN":       LwApiToken,
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	// Create new generic Cloud Account, the second apply verifies that
+	// the redacted private key does not cause a perpetual diff
+	create := terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	createData := GetGcpCfgIntegration(create)
+	assert.Equal(t, "Generic Cloud Account Example", createData.Data.Name)
+	assert.Equal(t, "techally-test", createData.Data.Data.ID)
+	assert.Equal(t, "GcpCfg", terraform.Output(t, terraformOptions, "type_name"))
+
+	// Update generic Cloud Account
+	terraformOptions.Vars["integration_name"] = "Generic Cloud Account Updated"
+
+	update := terraform.ApplyAndIdempotent(t, terraformOptions)
+	updateData := GetGcpCfgIntegration(update)
+	assert.Equal(t, "Generic Cloud Account Updated", updateData.Data.Name)
+}
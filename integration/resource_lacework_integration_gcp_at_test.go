@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIntegrationGcpAtCreate applies integration terraform:
+// => '../examples/resource_lacework_integration_gcp_at'
+//
+// It uses the go-sdk to verify the created integration,
+// applies an update with new integration name and destroys it
+func TestIntegrationGcpAtCreate(t *testing.T) {
+	gcreds, err := googleLoadDefaultCredentials()
+	if err != nil {
+		t.Skip("this test requires you to set GOOGLE_CREDENTIALS environment variable")
+	}
+
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_integration_gcp_at",
+		Vars: map[string]interface{}{
+			"client_id":      gcreds.ClientID,
+			"client_email":   gcreds.ClientEmail,
+			"private_key_id": gcreds.PrivateKeyID,
+		},
+		EnvVars: map[string]string{
+			"TF_VAR_private_key": gcreds.PrivateKey,
+			"LW_API_TOKEN":       LwApiToken,
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	// Create new Google Audit Trail
+	create := terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	createData := GetGcpAtSesIntegration(create)
+	assert.Equal(t, "Google Audit Trail Example", createData.Data.Name)
+	assert.Equal(t, "techally-test", createData.Data.Data.ID)
+	assert.Equal(t,
+		"projects/techally-test/subscriptions/lacework-audit-trail",
+		createData.Data.Data.SubscriptionName,
+	)
+
+	// Update Google Audit Trail
+	terraformOptions.Vars["integration_name"] = "Google Audit Trail Updated"
+
+	update := terraform.ApplyAndIdempotent(t, terraformOptions)
+	updateData := GetGcpAtSesIntegration(update)
+	assert.Equal(t, "Google Audit Trail Updated", updateData.Data.Name)
+}
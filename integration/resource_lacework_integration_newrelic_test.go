@@ -30,7 +30,6 @@ func TestNewRelicAlertChannelCreate(t *testing.T) {
 	assert.True(t, ok)
 
 	actualName := terraform.Output(t, terraformOptions, "channel_name")
-	actualInsertKey := terraform.Output(t, terraformOptions, "insert_key")
 	actualAccountID := terraform.Output(t, terraformOptions, "account_id")
 
 	assert.Equal(t, "NewRelic Insights Alert Channel Example", created.Data.Name)
@@ -38,7 +37,6 @@ func TestNewRelicAlertChannelCreate(t *testing.T) {
 	assert.Equal(t, json.Number("2338053"), data["accountId"])
 
 	assert.Equal(t, "NewRelic Insights Alert Channel Example", actualName)
-	assert.Equal(t, "x-xx-xxxxxxxxxxxxxxxxxx", actualInsertKey)
 	assert.Equal(t, "2.338053e+06", actualAccountID)
 
 	// Update NewRelic Insights Alert Channel
@@ -54,7 +52,6 @@ func TestNewRelicAlertChannelCreate(t *testing.T) {
 	assert.True(t, ok)
 
 	actualName = terraform.Output(t, terraformOptions, "channel_name")
-	actualInsertKey = terraform.Output(t, terraformOptions, "insert_key")
 	actualAccountID = terraform.Output(t, terraformOptions, "account_id")
 
 	assert.Equal(t, "NewRelic Insights Alert Channel Updated", updated.Data.Name)
@@ -62,6 +59,5 @@ func TestNewRelicAlertChannelCreate(t *testing.T) {
 	assert.Equal(t, json.Number("1338053"), data["accountId"])
 
 	assert.Equal(t, "NewRelic Insights Alert Channel Updated", actualName)
-	assert.Equal(t, "x-xx-xxxxxxxxxxxxxxxxxy", actualInsertKey)
 	assert.Equal(t, "1.338053e+06", actualAccountID)
 }
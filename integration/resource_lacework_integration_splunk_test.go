@@ -35,7 +35,6 @@ func TestSplunkAlertChannelCreate(t *testing.T) {
 	assert.True(t, ok)
 
 	actualName := terraform.Output(t, terraformOptions, "channel_name")
-	actualToken := terraform.Output(t, terraformOptions, "hec_token")
 	actualChannel := terraform.Output(t, terraformOptions, "channel")
 	actualHost := terraform.Output(t, terraformOptions, "host")
 	actualPort := terraform.Output(t, terraformOptions, "port")
@@ -49,7 +48,6 @@ func TestSplunkAlertChannelCreate(t *testing.T) {
 	assert.True(t, data["ssl"].(bool))
 
 	assert.Equal(t, "Splunk Alert Channel Example", actualName)
-	assert.Equal(t, "BA696D5E-CA2F-4347-97CB-3C89F834816F", actualToken)
 	assert.Equal(t, "Splunk Channel", actualChannel)
 	assert.Equal(t, "host", actualHost)
 	assert.Equal(t, "80", actualPort)
@@ -71,7 +69,6 @@ func TestSplunkAlertChannelCreate(t *testing.T) {
 	assert.True(t, ok)
 
 	actualName = terraform.Output(t, terraformOptions, "channel_name")
-	actualToken = terraform.Output(t, terraformOptions, "hec_token")
 	actualChannel = terraform.Output(t, terraformOptions, "channel")
 	actualHost = terraform.Output(t, terraformOptions, "host")
 	actualPort = terraform.Output(t, terraformOptions, "port")
@@ -85,7 +82,6 @@ func TestSplunkAlertChannelCreate(t *testing.T) {
 	assert.False(t, data["ssl"].(bool))
 
 	assert.Equal(t, "Splunk Alert Channel Updated", actualName)
-	assert.Equal(t, "BA696D5E-CA2F-4347-97CB-3C89F834815B", actualToken)
 	assert.Equal(t, "Updated Splunk Channel", actualChannel)
 	assert.Equal(t, "updated-host", actualHost)
 	assert.Equal(t, "8080", actualPort)
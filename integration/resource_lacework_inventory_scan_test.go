@@ -0,0 +1,30 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestInventoryScan applies integration terraform:
+// => '../examples/resource_lacework_inventory_scan'
+//
+// It triggers a scan of the AWS inventory, then verifies that changing
+// the triggers triggers a new scan
+func TestInventoryScan(t *testing.T) {
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_inventory_scan",
+		EnvVars:      tokenEnvVar,
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	scanTime := terraform.Output(t, terraformOptions, "scan_time")
+	assert.NotEmpty(t, scanTime)
+	assert.NotEmpty(t, terraform.Output(t, terraformOptions, "status"))
+
+	terraformOptions.Vars = map[string]interface{}{"scan": "2"}
+	terraform.Apply(t, terraformOptions)
+	assert.NotEqual(t, scanTime, terraform.Output(t, terraformOptions, "scan_time"))
+}
@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/lacework/go-sdk/v2/api"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -111,3 +113,59 @@ func TestManagedPoliciesWithCustomIDs(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "Unable to update custom policy ID")
 }
+
+// TestManagedPoliciesAuthoritative applies integration terraform:
+// => '../examples/resource_lacework_managed_policies_authoritative'
+//
+// It uses the go-sdk to verify that the lacework managed policies are restored to their
+// baseline on destroy, and that selected policies changed outside of Terraform are reverted.
+// nolint
+func TestManagedPoliciesAuthoritative(t *testing.T) {
+	var (
+		original         = GetPolicyPropsById("lacework-global-1")
+		originalSelected = GetPolicyPropsById("lacework-global-2")
+		severity         = "Low"
+	)
+	if original.Data.Severity == "low" {
+		severity = "High"
+	}
+
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_managed_policies_authoritative",
+		EnvVars:      tokenEnvVar,
+		Vars: map[string]interface{}{
+			"id":       "lacework-global-1",
+			"enabled":  !original.Data.Enabled,
+			"severity": severity,
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+	policyProps := GetPolicyPropsById("lacework-global-1")
+	assert.Equal(t, !original.Data.Enabled, policyProps.Data.Enabled)
+	assert.Equal(t, strings.ToLower(severity), policyProps.Data.Severity)
+
+	// Change a selected policy outside of Terraform
+	enabled := !originalSelected.Data.Enabled
+	_, err := LwClient.V2.Policy.UpdateMany(api.BulkUpdatePolicies{
+		{PolicyID: "lacework-global-2", Enabled: &enabled},
+	})
+	assert.NoError(t, err)
+
+	terraform.RunTerraformCommand(t, terraformOptions,
+		terraform.FormatArgs(terraformOptions, "apply", "-refresh-only", "-auto-approve", "-input=false")...)
+	drifted := terraform.OutputList(t, terraformOptions, "drifted_policies")
+	assert.Equal(t, []string{"lacework-global-2"}, drifted)
+
+	terraform.ApplyAndIdempotent(t, terraformOptions)
+	selectedProps := GetPolicyPropsById("lacework-global-2")
+	assert.Equal(t, originalSelected.Data.Enabled, selectedProps.Data.Enabled)
+
+	// Destroy restores the baseline
+	terraform.Destroy(t, terraformOptions)
+	restoredProps := GetPolicyPropsById("lacework-global-1")
+	assert.Equal(t, original.Data.Enabled, restoredProps.Data.Enabled)
+	assert.Equal(t, original.Data.Severity, restoredProps.Data.Severity)
+}
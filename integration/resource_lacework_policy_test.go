@@ -170,3 +170,50 @@ func TestPolicyCreateWithPolicyIDSuffix(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, msg, "unable to change ID of an existing policy")
 }
+
+// TestPolicyInlineQuery applies integration terraform:
+// => '../examples/resource_lacework_policy_inline_query'
+//
+// It verifies that the inline query is created with the policy, that renaming
+// the query replaces the previous query, and that the query is destroyed with the policy
+func TestPolicyInlineQuery(t *testing.T) {
+	rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+	queryID := fmt.Sprintf("Lql_Terraform_Policy_Inline_Query_%d", rand.Intn(1000))
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_policy_inline_query",
+		EnvVars:      tokenEnvVar,
+		Vars: map[string]interface{}{
+			"query_id": queryID,
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	// Create new Policy with an inline Query
+	create := terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	createProps := GetPolicyProps(create)
+
+	assert.Equal(t, queryID, createProps.Data.QueryID)
+	assert.Equal(t, queryID, terraform.Output(t, terraformOptions, "query_id"))
+
+	_, err := LwClient.V2.Query.Get(queryID)
+	assert.NoError(t, err)
+
+	// Rename the inline Query
+	renamedQueryID := queryID + "_Renamed"
+	terraformOptions.Vars["query_id"] = renamedQueryID
+
+	update := terraform.ApplyAndIdempotent(t, terraformOptions)
+	updateProps := GetPolicyProps(update)
+
+	assert.Equal(t, renamedQueryID, updateProps.Data.QueryID)
+	assert.Equal(t, renamedQueryID, terraform.Output(t, terraformOptions, "query_id"))
+
+	_, err = LwClient.V2.Query.Get(queryID)
+	assert.Error(t, err, "the previous query should have been deleted")
+
+	// Destroy the Policy and its Query
+	terraform.Destroy(t, terraformOptions)
+
+	_, err = LwClient.V2.Query.Get(renamedQueryID)
+	assert.Error(t, err, "the query should have been deleted with the policy")
+}
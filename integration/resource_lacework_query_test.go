@@ -180,6 +180,23 @@ func TestQueryDeprecatedSytaxWithID(t *testing.T) {
 	assert.Equal(t, updateQueryDeprecatedSyntaxWithID, actualQuery)
 }
 
+// TestQueryPlanInvalid verifies that an invalid query is reported
+// during the plan, before any resource is created
+func TestQueryPlanInvalid(t *testing.T) {
+	queryID := fmt.Sprintf("Lql_Terraform_Query_%d", time.Now().UnixMilli())
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_query",
+		EnvVars:      tokenEnvVar,
+		Vars: map[string]interface{}{
+			"query_id": queryID,
+			"query":    invalidQueryString},
+	})
+
+	msg, err := terraform.InitAndPlanE(t, terraformOptions)
+	assert.Error(t, err)
+	assert.Contains(t, msg, "invalid LQL query")
+}
+
 var (
 	queryString = `{
     source {
@@ -237,6 +254,15 @@ var (
     }        
 }`
 
+	invalidQueryString = `{
+    source {
+        CloudTrailRawEventsThatDoNotExist
+    }
+    return distinct {
+        EVENT
+    }
+}`
+
 	queryDeprecatedSyntaxWithID = `Lql_Terraform_Query {
     source {
         CloudTrailRawEvents
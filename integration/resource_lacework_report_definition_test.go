@@ -0,0 +1,43 @@
+package integration
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestReportDefinitionCreate applies integration terraform:
+// => '../examples/resource_lacework_report_definition'
+//
+// It uses the go-sdk to verify the created report definition,
+// applies an update that creates a new version and destroys it
+func TestReportDefinitionCreate(t *testing.T) {
+	name := fmt.Sprintf("Terraform Test Report Definition - %s", time.Now())
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_report_definition",
+		EnvVars:      tokenEnvVar,
+		Vars: map[string]interface{}{
+			"name":          name,
+			"section_title": "IAM",
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	// Create new Report Definition
+	create := terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	createProps := GetReportDefinitionProps(create)
+	assert.Equal(t, name, createProps.Data.ReportName)
+	assert.Equal(t, "IAM", createProps.Data.ReportDefinitionDetails.Sections[0].Title)
+	assert.Equal(t, fmt.Sprint(createProps.Data.Version), terraform.Output(t, terraformOptions, "version"))
+
+	// Update Report Definition
+	terraformOptions.Vars["section_title"] = "Updated IAM"
+
+	update := terraform.ApplyAndIdempotent(t, terraformOptions)
+	updateProps := GetReportDefinitionProps(update)
+	assert.Equal(t, "Updated IAM", updateProps.Data.ReportDefinitionDetails.Sections[0].Title)
+	assert.Greater(t, updateProps.Data.Version, createProps.Data.Version)
+}
@@ -0,0 +1,44 @@
+package integration
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestReportDistributionCreate applies integration terraform:
+// => '../examples/resource_lacework_report_distribution'
+//
+// It uses the go-sdk to verify the created report distribution,
+// applies an update that changes the frequency and destroys it
+func TestReportDistributionCreate(t *testing.T) {
+	name := fmt.Sprintf("Terraform Test Report Distribution - %s", time.Now())
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_report_distribution",
+		EnvVars:      tokenEnvVar,
+		Vars: map[string]interface{}{
+			"name":      name,
+			"frequency": "daily",
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	// Create new Report Distribution
+	terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	createProps := GetReportDistributionProps(terraform.Output(t, terraformOptions, "id"))
+	assert.Equal(t, name, createProps.Data.DistributionName)
+	assert.Equal(t, "daily", createProps.Data.Frequency)
+	assert.Equal(t, []string{"NonCompliant"}, createProps.Data.Data.Violations)
+	assert.Equal(t, "123456789012", createProps.Data.Data.Integrations[0].AccountID)
+
+	// Update Report Distribution
+	terraformOptions.Vars["frequency"] = "weekly"
+
+	terraform.ApplyAndIdempotent(t, terraformOptions)
+	updateProps := GetReportDistributionProps(terraform.Output(t, terraformOptions, "id"))
+	assert.Equal(t, "weekly", updateProps.Data.Frequency)
+	assert.Equal(t, "weekly", terraform.Output(t, terraformOptions, "frequency"))
+}
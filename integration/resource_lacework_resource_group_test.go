@@ -38,3 +38,36 @@ func TestResourceGroupCreate(t *testing.T) {
 	update := terraform.ApplyAndIdempotent(t, terraformOptions)
 	assert.Equal(t, "Updated Terraform Test Resource Group V2", GetResourceGroupV2Description(update))
 }
+
+// TestResourceGroupExpressionJSON applies integration terraform:
+// => '../examples/resource_lacework_resource_group_expression_json'
+//
+// It verifies that an expression deeper than the supported group blocks can be
+// created, that it does not diff after a refresh and that it can be imported
+func TestResourceGroupExpressionJSON(t *testing.T) {
+	name := fmt.Sprintf("Terraform Test Resource Group Expression JSON - %s", time.Now())
+	terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+		TerraformDir: "../examples/resource_lacework_resource_group_expression_json",
+		EnvVars:      tokenEnvVar,
+		Vars: map[string]interface{}{
+			"resource_group_name": name,
+			"description":         "Terraform Test Resource Group Expression JSON",
+		},
+	})
+	defer terraform.Destroy(t, terraformOptions)
+
+	create := terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+	assert.Equal(t, "Terraform Test Resource Group Expression JSON", GetResourceGroupV2Description(create))
+	assert.Contains(t, terraform.Output(t, terraformOptions, "expression_json"), `"filterName":"acct2"`)
+
+	// Re-import the resource group, the expression exceeds the block depth
+	// so the import must fall back to expression_json
+	id := terraform.Output(t, terraformOptions, "id")
+	terraform.RunTerraformCommand(t, terraformOptions,
+		terraform.FormatArgs(terraformOptions, "state", "rm", "lacework_resource_group.example")...)
+	terraform.RunTerraformCommand(t, terraformOptions,
+		terraform.FormatArgs(terraformOptions, "import", "lacework_resource_group.example", id)...)
+
+	exitCode := terraform.PlanExitCode(t, terraformOptions)
+	assert.Equal(t, 0, exitCode)
+}
@@ -32,6 +32,18 @@ func castAndTransformStringSlice(iArray []interface{}, f func(string) string) []
 	return a
 }
 
+// turn an interface slice into an int slice
+func castIntSlice(iArray []interface{}) []int {
+	a := make([]int, 0, len(iArray))
+	for _, v := range iArray {
+		if v == nil {
+			continue
+		}
+		a = append(a, v.(int))
+	}
+	return a
+}
+
 // turn a string slice into an instance slice
 func castStringSliceToInterface(strs []string) []interface{} {
 	arr := make([]interface{}, len(strs))
@@ -134,3 +146,12 @@ func ContainsStr(array []string, expected string) bool {
 	}
 	return false
 }
+
+func ContainsInt(array []int, expected int) bool {
+	for _, value := range array {
+		if expected == value {
+			return true
+		}
+	}
+	return false
+}
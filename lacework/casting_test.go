@@ -128,3 +128,14 @@ func TestCastArrayOfStringKeyMapOfStringsToLimitByLabelSet(t *testing.T) {
 		"%s did not match expected value: %s", subject, expected,
 	)
 }
+
+func TestCastIntSlice(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, castIntSlice([]interface{}{1, nil, 2, 3}))
+	assert.Equal(t, []int{}, castIntSlice([]interface{}{}))
+}
+
+func TestContainsInt(t *testing.T) {
+	assert.True(t, ContainsInt([]int{1, 2, 3}, 2))
+	assert.False(t, ContainsInt([]int{1, 2, 3}, 4))
+	assert.False(t, ContainsInt([]int{}, 1))
+}
@@ -0,0 +1,210 @@
+package lacework
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "-24h",
+				Description: "The start of the time window, either an RFC3339 time or a relative " +
+					"time specifier like -24h or -7d@d",
+				ValidateDiagFunc: ValidTimeWindowValue(),
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "now",
+				Description: "The end of the time window, either an RFC3339 time or a relative " +
+					"time specifier like now or -1h",
+				ValidateDiagFunc: ValidTimeWindowValue(),
+			},
+			"severities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only return alerts with one of the provided severities",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: ValidSeverity(),
+				},
+			},
+			"statuses": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only return alerts with one of the provided statuses (Open, Closed)",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(api.ValidAlertStatuses, false),
+				},
+			},
+			"alert_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only return alerts with one of the provided alert types",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The ids of all alerts that matched the filters",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"alerts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The alerts that matched the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_profile": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sub_category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reachability": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	start, end, err := getTimeWindow(d, "start_time", "end_time")
+	if err != nil {
+		return err
+	}
+
+	var (
+		severities = castAndTransformStringSlice(d.Get("severities").(*schema.Set).List(), strings.ToLower)
+		statuses   = castStringSlice(d.Get("statuses").(*schema.Set).List())
+		alertTypes = castStringSlice(d.Get("alert_types").(*schema.Set).List())
+	)
+
+	log.Printf("[INFO] Listing Alerts from %s to %s\n", start, end)
+	response, err := lacework.V2.Alerts.ListAllByTime(start, end)
+	if err != nil {
+		return err
+	}
+
+	response.Data.SortByID()
+
+	var (
+		ids    = make([]int, 0)
+		alerts = make([]map[string]interface{}, 0)
+	)
+	for _, alert := range response.Data {
+		if len(severities) != 0 && !ContainsStr(severities, strings.ToLower(alert.Severity)) {
+			continue
+		}
+		if len(statuses) != 0 && !ContainsStr(statuses, alert.Status) {
+			continue
+		}
+		if len(alertTypes) != 0 && !ContainsStr(alertTypes, alert.Type) {
+			continue
+		}
+
+		ids = append(ids, alert.ID)
+		alerts = append(alerts, map[string]interface{}{
+			"id":            alert.ID,
+			"name":          alert.Name,
+			"type":          alert.Type,
+			"severity":      alert.Severity,
+			"status":        alert.Status,
+			"subject":       alert.Info.Subject,
+			"description":   alert.Info.Description,
+			"start_time":    alert.StartTime,
+			"end_time":      alert.EndTime,
+			"updated_time":  alert.UpdateTime,
+			"policy_id":     alert.PolicyID,
+			"alert_profile": alert.Spec.Profile,
+			"category":      alert.DerivedFields.Category,
+			"sub_category":  alert.DerivedFields.SubCategory,
+			"source":        alert.DerivedFields.Source,
+			"reachability":  alert.Reachability,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ids", ids)
+	d.Set("alerts", alerts)
+
+	log.Printf("[INFO] Found %d Alerts matching the filters\n", len(ids))
+	return nil
+}
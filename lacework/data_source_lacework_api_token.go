@@ -1,9 +1,11 @@
 package lacework
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/lacework/go-sdk/v2/api"
 )
@@ -12,19 +14,28 @@ func dataSourceLaceworkApiToken() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceLaceworkApiTokenRead,
 		Schema: map[string]*schema.Schema{
+			"expiry_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 86400),
+				Description:  "The number of seconds the API access token is valid for, defaults to 3600",
+			},
 			"token": {
 				Type:      schema.TypeString,
 				Computed:  true,
 				Sensitive: true,
 			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the API access token expires",
+			},
 		},
 	}
 }
 
 func dataSourceLaceworkApiTokenRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
-
-	response, err := lacework.GenerateToken()
+	response, err := generateApiToken(meta.(*providerMeta), d.Get("expiry_seconds").(int))
 	if err != nil {
 		// return the api client error directly since it is user friendly
 		return err
@@ -32,6 +43,22 @@ func dataSourceLaceworkApiTokenRead(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(time.Now().UTC().String())
 	d.Set("token", response.Token)
+	d.Set("expires_at", response.ExpiresAt.UTC().Format(time.RFC3339))
 
 	return nil
 }
+
+// generateApiToken generates an API access token, tokens that expire after the provided
+// number of seconds are generated with a separate client so that the expiration and the
+// token of the provider client are not changed
+func generateApiToken(lacework *providerMeta, expiry int) (*api.TokenData, error) {
+	if expiry == 0 {
+		return lacework.client.GenerateToken()
+	}
+
+	client, err := lacework.newClient(api.WithExpirationTime(expiry))
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate API access token: %s", err)
+	}
+	return client.GenerateToken()
+}
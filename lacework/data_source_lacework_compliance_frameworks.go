@@ -0,0 +1,196 @@
+package lacework
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkComplianceFrameworks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkComplianceFrameworksRead,
+
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return frameworks that apply to the provided cloud (i.e. AWS, Azure, GCP)",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only return the framework with the provided name (i.e. CIS AWS 1.5)",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"policy_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The ids of all policies mapped to the frameworks that matched the filters",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"frameworks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The frameworks that matched the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"guid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domains": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"policy_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"section": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"policy_ids": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkComplianceFrameworksRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	filter := frameworksFilter{
+		cloud: d.Get("cloud").(string),
+		name:  d.Get("name").(string),
+	}
+
+	log.Printf("[INFO] Listing Compliance Frameworks with filter: %+v\n", filter)
+	response, err := lacework.V2.Frameworks.List()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(response.Data, func(i, j int) bool {
+		return response.Data[i].Name < response.Data[j].Name
+	})
+
+	var (
+		policyIDs  = make(map[string]bool)
+		frameworks = make([]map[string]interface{}, 0)
+	)
+	for _, framework := range response.Data {
+		if !filter.match(framework) {
+			continue
+		}
+
+		flat := flattenComplianceFramework(framework)
+		for _, id := range flat["policy_ids"].([]string) {
+			policyIDs[id] = true
+		}
+		frameworks = append(frameworks, flat)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("policy_ids", sortedKeys(policyIDs))
+	d.Set("frameworks", frameworks)
+
+	log.Printf("[INFO] Found %d Compliance Frameworks matching the filter\n", len(frameworks))
+	return nil
+}
+
+// frameworksFilter holds the filters of the lacework_compliance_frameworks data source,
+// both the cloud and the name are compared without case sensitivity
+type frameworksFilter struct {
+	cloud string
+	name  string
+}
+
+func (f frameworksFilter) match(framework api.Framework) bool {
+	if f.name != "" && !strings.EqualFold(f.name, framework.Name) {
+		return false
+	}
+
+	if f.cloud != "" {
+		for _, domain := range framework.Domains {
+			if strings.EqualFold(f.cloud, domain) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// flattenComplianceFramework flattens a framework and maps the ids of the policies
+// to each section, the framework policy ids are sorted and unique
+func flattenComplianceFramework(framework api.Framework) map[string]interface{} {
+	var (
+		policyIDs = make(map[string]bool)
+		sections  = make([]map[string]interface{}, 0, len(framework.Sections))
+	)
+	for _, section := range framework.Sections {
+		ids := make([]string, 0, len(section.Policies))
+		for _, policy := range section.Policies {
+			ids = append(ids, policy.PolicyID)
+			policyIDs[policy.PolicyID] = true
+		}
+
+		sections = append(sections, map[string]interface{}{
+			"name":       section.Name,
+			"policy_ids": ids,
+		})
+	}
+
+	return map[string]interface{}{
+		"guid":       framework.Guid,
+		"name":       framework.Name,
+		"domains":    framework.Domains,
+		"owner":      framework.Owner,
+		"revision":   framework.Revision,
+		"policy_ids": sortedKeys(policyIDs),
+		"section":    sections,
+	}
+}
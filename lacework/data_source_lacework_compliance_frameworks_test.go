@@ -0,0 +1,40 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameworksFilterMatch(t *testing.T) {
+	framework := api.Framework{Name: "CIS AWS 1.5", Domains: []string{"AWS"}}
+
+	assert.True(t, frameworksFilter{}.match(framework))
+	assert.True(t, frameworksFilter{cloud: "aws"}.match(framework))
+	assert.False(t, frameworksFilter{cloud: "GCP"}.match(framework))
+	assert.True(t, frameworksFilter{name: "cis aws 1.5"}.match(framework))
+	assert.False(t, frameworksFilter{name: "CIS AWS 1.4"}.match(framework))
+	assert.False(t, frameworksFilter{cloud: "AWS", name: "CIS AWS 1.4"}.match(framework))
+}
+
+func TestFlattenComplianceFramework(t *testing.T) {
+	framework := api.Framework{
+		Name:    "CIS AWS 1.5",
+		Domains: []string{"AWS"},
+		Sections: []api.Section{
+			{Name: "Logging", Policies: []api.Policy{{PolicyID: "lacework-global-54"}}},
+			{Name: "IAM", Policies: []api.Policy{{PolicyID: "lacework-global-32"}, {PolicyID: "lacework-global-31"}}},
+			{Name: "Monitoring", Policies: []api.Policy{{PolicyID: "lacework-global-54"}}},
+		},
+	}
+
+	flat := flattenComplianceFramework(framework)
+	assert.Equal(t, []string{"lacework-global-31", "lacework-global-32", "lacework-global-54"}, flat["policy_ids"])
+
+	sections := flat["section"].([]map[string]interface{})
+	if assert.Len(t, sections, 3) {
+		assert.Equal(t, "IAM", sections[1]["name"])
+		assert.Equal(t, []string{"lacework-global-32", "lacework-global-31"}, sections[1]["policy_ids"])
+	}
+}
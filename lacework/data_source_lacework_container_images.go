@@ -0,0 +1,110 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkContainerImages() *schema.Resource {
+	resourceSchema := entitySearchSchema("images", "container images", map[string]*schema.Schema{
+		"image_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"repo": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tag": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mid": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"size": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"container_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	})
+	resourceSchema["image_ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The sorted and unique ids of the container images that matched the filters",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLaceworkContainerImagesRead,
+		Schema: resourceSchema,
+	}
+}
+
+func dataSourceLaceworkContainerImagesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	filter, err := expandEntitySearchFilter(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Searching container images with filters %v\n", filter.Filters)
+	response, err := lacework.V2.Entities.ListAllImagesWithFilters(filter)
+	if err != nil {
+		return fmt.Errorf("unable to search container images: %s", err)
+	}
+
+	images, imageIDs := flattenImageEntities(response.Data)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("image_ids", imageIDs)
+	d.Set("images", images)
+
+	log.Printf("[INFO] Found %d container images matching the filters\n", len(images))
+	return nil
+}
+
+// flattenImageEntities flattens the container images and returns their sorted and unique ids
+func flattenImageEntities(entities []api.ImageEntity) ([]map[string]interface{}, []string) {
+	var (
+		images   = make([]map[string]interface{}, 0, len(entities))
+		imageIDs = make([]string, 0, len(entities))
+		seen     = map[string]bool{}
+	)
+
+	for _, entity := range entities {
+		images = append(images, map[string]interface{}{
+			"image_id":       entity.ImageID,
+			"repo":           entity.Repo,
+			"tag":            entity.Tag,
+			"mid":            entity.Mid,
+			"size":           entity.Size,
+			"container_type": entity.ContainerType,
+			"created_time":   formatSearchTime(entity.CreatedTime),
+		})
+
+		if entity.ImageID != "" && !seen[entity.ImageID] {
+			seen[entity.ImageID] = true
+			imageIDs = append(imageIDs, entity.ImageID)
+		}
+	}
+	sort.Strings(imageIDs)
+	return images, imageIDs
+}
@@ -0,0 +1,130 @@
+package lacework
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLaceworkContainerVulnerabilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkContainerVulnerabilitiesRead,
+
+		Schema: vulnerabilitySearchSchema(map[string]*schema.Schema{
+			"vuln_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"eval_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_registry": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_repository": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"package_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fix_available": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"fixed_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+func dataSourceLaceworkContainerVulnerabilitiesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	filter, err := expandVulnerabilitySearchFilter(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Searching container vulnerabilities from %s to %s\n",
+		filter.TimeFilter.StartTime, filter.TimeFilter.EndTime)
+	response, err := lacework.V2.Vulnerabilities.Containers.SearchAllPages(filter)
+	if err != nil {
+		return err
+	}
+
+	var (
+		vulnIDs         = make([]string, 0, len(response.Data))
+		vulnerabilities = make([]map[string]interface{}, 0, len(response.Data))
+	)
+	for _, vuln := range response.Data {
+		image := vuln.EvalCtx.ImageInfo
+		vulnIDs = append(vulnIDs, vuln.VulnID)
+		vulnerabilities = append(vulnerabilities, map[string]interface{}{
+			"vuln_id":           vuln.VulnID,
+			"severity":          vuln.Severity,
+			"status":            vuln.Status,
+			"start_time":        formatSearchTime(vuln.StartTime),
+			"eval_guid":         vuln.EvalGUID,
+			"image_id":          vuln.ImageID,
+			"image_registry":    image.Registry,
+			"image_repository":  image.Repo,
+			"image_digest":      image.Digest,
+			"image_tags":        image.Tags,
+			"package_name":      vuln.FeatureKey.Name,
+			"package_namespace": vuln.FeatureKey.Namespace,
+			"package_version":   vuln.FeatureKey.Version,
+			"fix_available":     vuln.FixInfo.FixAvailable == 1,
+			"fixed_version":     vuln.FixInfo.FixedVersion,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := setVulnerabilitySearchResults(d, response.Data, vulnIDs, vulnerabilities); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Found %d container vulnerabilities matching the filters\n", len(vulnerabilities))
+	return nil
+}
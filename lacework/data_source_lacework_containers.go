@@ -0,0 +1,133 @@
+package lacework
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkContainers() *schema.Resource {
+	resourceSchema := entitySearchSchema("containers", "containers", map[string]*schema.Schema{
+		"container_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"image_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mid": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"pod_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"start_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"end_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"props_container": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The JSON encoded properties of the container",
+		},
+	})
+	resourceSchema["image_ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The sorted and unique ids of the images of the containers that matched the filters",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLaceworkContainersRead,
+		Schema: resourceSchema,
+	}
+}
+
+func dataSourceLaceworkContainersRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	filter, err := expandEntitySearchFilter(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Searching containers with filters %v\n", filter.Filters)
+	response, err := lacework.V2.Entities.ListAllContainersWithFilters(filter)
+	if err != nil {
+		return fmt.Errorf("unable to search containers: %s", err)
+	}
+
+	containers, imageIDs, err := flattenContainerEntities(response.Data)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("image_ids", imageIDs)
+	d.Set("containers", containers)
+
+	log.Printf("[INFO] Found %d containers matching the filters\n", len(containers))
+	return nil
+}
+
+// flattenContainerEntities flattens the containers and returns the sorted and unique ids of their images
+func flattenContainerEntities(entities []api.ContainerEntity) ([]map[string]interface{}, []string, error) {
+	var (
+		containers = make([]map[string]interface{}, 0, len(entities))
+		imageIDs   = make([]string, 0, len(entities))
+		seen       = map[string]bool{}
+	)
+
+	for _, entity := range entities {
+		tags, err := flattenEntityTags(entity.Tags)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read the tags of container %s: %s", entity.ContainerName, err)
+		}
+		props, err := json.Marshal(entity.PropsContainer)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to encode the properties of container %s: %s",
+				entity.ContainerName, err)
+		}
+
+		containers = append(containers, map[string]interface{}{
+			"container_name":  entity.ContainerName,
+			"image_id":        entity.ImageID,
+			"mid":             entity.Mid,
+			"pod_name":        entity.PodName,
+			"start_time":      formatSearchTime(entity.StartTime),
+			"end_time":        formatSearchTime(entity.EndTime),
+			"tags":            tags,
+			"props_container": string(props),
+		})
+
+		if entity.ImageID != "" && !seen[entity.ImageID] {
+			seen[entity.ImageID] = true
+			imageIDs = append(imageIDs, entity.ImageID)
+		}
+	}
+	sort.Strings(imageIDs)
+	return containers, imageIDs, nil
+}
@@ -0,0 +1,126 @@
+package lacework
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLaceworkHostVulnerabilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkHostVulnerabilitiesRead,
+
+		Schema: vulnerabilitySearchSchema(map[string]*schema.Schema{
+			"vuln_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"eval_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mid": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"fix_available": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"fixed_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+func dataSourceLaceworkHostVulnerabilitiesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	filter, err := expandVulnerabilitySearchFilter(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Searching host vulnerabilities from %s to %s\n",
+		filter.TimeFilter.StartTime, filter.TimeFilter.EndTime)
+	response, err := lacework.V2.Vulnerabilities.Hosts.SearchAllPages(filter)
+	if err != nil {
+		return err
+	}
+
+	var (
+		vulnIDs         = make([]string, 0, len(response.Data))
+		vulnerabilities = make([]map[string]interface{}, 0, len(response.Data))
+	)
+	for _, vuln := range response.Data {
+		vulnIDs = append(vulnIDs, vuln.VulnID)
+		vulnerabilities = append(vulnerabilities, map[string]interface{}{
+			"vuln_id":           vuln.VulnID,
+			"severity":          vuln.Severity,
+			"status":            vuln.Status,
+			"start_time":        formatSearchTime(vuln.StartTime),
+			"end_time":          formatSearchTime(vuln.EndTime),
+			"eval_guid":         vuln.EvalGUID,
+			"mid":               vuln.Mid,
+			"hostname":          vuln.EvalCtx.Hostname,
+			"package_name":      vuln.FeatureKey.Name,
+			"package_namespace": vuln.FeatureKey.Namespace,
+			"package_version":   vuln.FeatureKey.VersionInstalled,
+			"package_active":    vuln.FeatureKey.PackageActive == 1,
+			"fix_available":     vuln.HasFix(),
+			"fixed_version":     vuln.FixInfo.FixedVersion,
+			"link":              vuln.CveProps.Link,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := setVulnerabilitySearchResults(d, response.Data, vulnIDs, vulnerabilities); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Found %d host vulnerabilities matching the filters\n", len(vulnerabilities))
+	return nil
+}
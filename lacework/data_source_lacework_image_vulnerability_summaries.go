@@ -0,0 +1,207 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkImageVulnerabilitySummaries() *schema.Resource {
+	image := map[string]*schema.Schema{
+		"image_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"digest": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"registry": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"repository": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tag": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"container_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"last_scan_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"scan_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	resourceSchema := map[string]*schema.Schema{
+		"start_time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "-24h",
+			Description: "The start of the time window, either an RFC3339 time or a relative " +
+				"time specifier like -24h or -7d@d",
+			ValidateDiagFunc: ValidTimeWindowValue(),
+		},
+		"end_time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "now",
+			Description: "The end of the time window, either an RFC3339 time or a relative " +
+				"time specifier like now or -1h",
+			ValidateDiagFunc: ValidTimeWindowValue(),
+		},
+		"registries": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Only return images from one of the provided registries",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"repositories": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Only return images from one of the provided repositories",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Only return images with one of the provided tags",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"images": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The vulnerability summaries of the images that matched the filters",
+			Elem: &schema.Resource{
+				Schema: image,
+			},
+		},
+	}
+
+	for _, severity := range containerAssessmentSeverities {
+		image[severity+"_count"] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+		image[severity+"_fixable_count"] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		}
+		resourceSchema[severity+"_count"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("The number of %s vulnerabilities of all the images", severity),
+		}
+		resourceSchema[severity+"_fixable_count"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("The number of fixable %s vulnerabilities of all the images", severity),
+		}
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLaceworkImageVulnerabilitySummariesRead,
+		Schema: resourceSchema,
+	}
+}
+
+func dataSourceLaceworkImageVulnerabilitySummariesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	start, end, err := getTimeWindow(d, "start_time", "end_time")
+	if err != nil {
+		return err
+	}
+
+	filter := api.SearchFilter{
+		TimeFilter: &api.TimeFilter{StartTime: &start, EndTime: &end},
+	}
+	for attribute, field := range map[string]string{
+		"registries":   "registry",
+		"repositories": "repository",
+		"tags":         "tag",
+	} {
+		values := castStringSlice(d.Get(attribute).(*schema.Set).List())
+		if len(values) != 0 {
+			filter.Filters = append(filter.Filters, api.Filter{Field: field, Expression: "in", Values: values})
+		}
+	}
+
+	log.Printf("[INFO] Searching image vulnerability summaries from %s to %s\n", start, end)
+	response, err := lacework.V2.VulnerabilityObservations.ImageSummary.SearchAllPages(filter)
+	if err != nil {
+		return err
+	}
+
+	images, totals := flattenImageVulnerabilitySummaries(response.Data)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("images", images)
+	for key, count := range totals {
+		d.Set(key, count)
+	}
+
+	log.Printf("[INFO] Found %d image vulnerability summaries matching the filters\n", len(images))
+	return nil
+}
+
+// flattenImageVulnerabilitySummaries flattens the vulnerability summaries of the images and
+// returns the number of vulnerabilities per severity of all the images
+func flattenImageVulnerabilitySummaries(summaries []api.VulnerabilityObservationsImageSummary) (
+	[]map[string]interface{}, map[string]int) {
+	var (
+		images = make([]map[string]interface{}, 0, len(summaries))
+		totals = map[string]int{}
+	)
+	for _, severity := range containerAssessmentSeverities {
+		totals[severity+"_count"] = 0
+		totals[severity+"_fixable_count"] = 0
+	}
+
+	for _, summary := range summaries {
+		image := map[string]interface{}{
+			"image_id":               summary.ImageId,
+			"digest":                 summary.Digest,
+			"registry":               summary.Registry,
+			"repository":             summary.Repository,
+			"tag":                    summary.Tag,
+			"container_count":        summary.ContainerCount,
+			"last_scan_time":         summary.LastScanTime,
+			"scan_status":            summary.ScanStatus,
+			"critical_count":         summary.VulnCountCritical,
+			"critical_fixable_count": summary.VulnCountCriticalFixable,
+			"high_count":             summary.VulnCountHigh,
+			"high_fixable_count":     summary.VulnCountHighFixable,
+			"medium_count":           summary.VulnCountMedium,
+			"medium_fixable_count":   summary.VulnCountMediumFixable,
+			"low_count":              summary.VulnCountLow,
+			"low_fixable_count":      summary.VulnCountLowFixable,
+			"info_count":             summary.VulnCountInfo,
+			"info_fixable_count":     summary.VulnCountInfoFixable,
+		}
+		for key := range totals {
+			totals[key] += image[key].(int)
+		}
+		images = append(images, image)
+	}
+	return images, totals
+}
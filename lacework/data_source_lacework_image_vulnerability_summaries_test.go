@@ -0,0 +1,43 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenImageVulnerabilitySummaries(t *testing.T) {
+	images, totals := flattenImageVulnerabilitySummaries([]api.VulnerabilityObservationsImageSummary{
+		{
+			ImageId:                  "sha256:1",
+			Registry:                 "index.docker.io",
+			Repository:               "my-org/app",
+			Tag:                      "v1",
+			ContainerCount:           3,
+			VulnCountCritical:        2,
+			VulnCountCriticalFixable: 1,
+			VulnCountHigh:            5,
+		},
+		{
+			ImageId:              "sha256:2",
+			Registry:             "index.docker.io",
+			Repository:           "my-org/app",
+			Tag:                  "v2",
+			VulnCountHigh:        1,
+			VulnCountHighFixable: 1,
+		},
+	})
+
+	if assert.Len(t, images, 2) {
+		assert.Equal(t, "sha256:1", images[0]["image_id"])
+		assert.Equal(t, 3, images[0]["container_count"])
+		assert.Equal(t, 2, images[0]["critical_count"])
+		assert.Equal(t, "v2", images[1]["tag"])
+	}
+	assert.Equal(t, 2, totals["critical_count"])
+	assert.Equal(t, 1, totals["critical_fixable_count"])
+	assert.Equal(t, 6, totals["high_count"])
+	assert.Equal(t, 1, totals["high_fixable_count"])
+	assert.Equal(t, 0, totals["low_count"])
+}
@@ -0,0 +1,238 @@
+package lacework
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkInventoryResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkInventoryResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  fmt.Sprintf("The cloud provider of the inventory, one of %v", inventoryClouds),
+				ValidateFunc: validation.StringInSlice(inventoryClouds, false),
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "-24h",
+				Description: "The start of the time window, either an RFC3339 time or a relative " +
+					"time specifier like -24h or -7d@d",
+				ValidateDiagFunc: ValidTimeWindowValue(),
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "now",
+				Description: "The end of the time window, either an RFC3339 time or a relative " +
+					"time specifier like now or -1h",
+				ValidateDiagFunc: ValidTimeWindowValue(),
+			},
+			"resource_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only return the resources of one of the provided types, i.e. s3:bucket",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"regions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only return the resources in one of the provided regions",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "Only return the resources of one of the provided AWS accounts, " +
+					"Azure subscriptions or GCP projects",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only return the resources with all the provided tags",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"urns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The sorted and unique urns of the resources that matched the filters",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources that matched the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"urn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"resource_config": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The JSON encoded configuration of the resource",
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkInventoryResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	search, err := expandInventorySearch(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Searching %s inventory with filters %v\n", search.Csp, search.Filters)
+	resources, err := searchInventory(lacework, search)
+	if err != nil {
+		return err
+	}
+
+	flattened, urns, err := flattenInventoryResources(d.Get("cloud").(string), resources)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("urns", urns)
+	d.Set("resources", flattened)
+
+	log.Printf("[INFO] Found %d %s inventory resources matching the filters\n", len(flattened), search.Csp)
+	return nil
+}
+
+// expandInventorySearch builds the search of the inventory data source
+func expandInventorySearch(d *schema.ResourceData) (api.InventorySearch, error) {
+	start, end, err := getTimeWindow(d, "start_time", "end_time")
+	if err != nil {
+		return api.InventorySearch{}, err
+	}
+
+	cloud := d.Get("cloud").(string)
+	search, err := newInventorySearch(cloud, start, end)
+	if err != nil {
+		return search, err
+	}
+
+	for _, filter := range []struct{ attribute, field string }{
+		{"resource_types", "resourceType"},
+		{"regions", "resourceRegion"},
+		{"accounts", "cloudDetails." + inventoryAccountDetails[cloud]},
+	} {
+		values := castStringSlice(d.Get(filter.attribute).(*schema.Set).List())
+		if len(values) != 0 {
+			sort.Strings(values)
+			search.Filters = append(search.Filters, api.Filter{Field: filter.field, Expression: "in", Values: values})
+		}
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	for _, key := range sortedKeys(tags) {
+		search.Filters = append(search.Filters, api.Filter{
+			Field:      "resourceTags." + key,
+			Expression: "eq",
+			Value:      tags[key].(string),
+		})
+	}
+	return search, nil
+}
+
+// flattenInventoryResources flattens the inventory resources and returns their sorted and unique urns
+func flattenInventoryResources(cloud string, resources []inventoryResource) (
+	[]map[string]interface{}, []string, error) {
+	var (
+		flattened = make([]map[string]interface{}, 0, len(resources))
+		urns      = make([]string, 0, len(resources))
+		seen      = map[string]bool{}
+	)
+
+	for _, resource := range resources {
+		config, err := json.Marshal(resource.ResourceConfig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to encode the configuration of resource %s: %s", resource.Urn, err)
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"urn":             resource.Urn,
+			"resource_id":     resource.ResourceId,
+			"resource_type":   resource.ResourceType,
+			"region":          resource.ResourceRegion,
+			"service":         resource.Service,
+			"account":         inventoryAccountID(cloud, resource),
+			"tags":            inventoryTags(resource),
+			"resource_config": string(config),
+			"start_time":      resource.StartTime,
+			"end_time":        resource.EndTime,
+		})
+
+		if resource.Urn != "" && !seen[resource.Urn] {
+			seen[resource.Urn] = true
+			urns = append(urns, resource.Urn)
+		}
+	}
+	sort.Strings(urns)
+	return flattened, urns, nil
+}
@@ -0,0 +1,153 @@
+package lacework
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkLqlDatasource() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkLqlDatasourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the datasource (i.e. LW_CFG_AWS_S3)",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the datasource",
+			},
+			"field_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the fields of the datasource",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"field_types": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The data types of the fields of the datasource, indexed by field name",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The fields of the datasource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"relationships": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The relationships of the datasource with other datasources",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_cardinality": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkLqlDatasourceRead(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework = meta.(*providerMeta).client
+		name     = d.Get("name").(string)
+	)
+
+	log.Printf("[INFO] Reading LQL Datasource %s\n", name)
+	response, err := lacework.V2.Datasources.Get(name)
+	if err != nil {
+		return err
+	}
+
+	datasource := response.Data
+	fieldNames, fieldTypes, fields := flattenLqlDatasourceFields(datasource.ResultSchema)
+
+	relationships := make([]map[string]interface{}, 0, len(datasource.SourceRelationships))
+	for _, relationship := range datasource.SourceRelationships {
+		relationships = append(relationships, map[string]interface{}{
+			"name":           relationship.Name,
+			"description":    relationship.Description,
+			"from":           relationship.From,
+			"to":             relationship.To,
+			"to_cardinality": relationship.ToCardinality,
+		})
+	}
+
+	d.SetId(datasource.Name)
+	d.Set("description", datasource.Description)
+	d.Set("field_names", fieldNames)
+	d.Set("field_types", fieldTypes)
+	d.Set("fields", fields)
+	d.Set("relationships", relationships)
+
+	log.Printf("[INFO] Read LQL Datasource %s with %d fields\n", datasource.Name, len(fieldNames))
+	return nil
+}
+
+// flattenLqlDatasourceFields returns the names of the fields of a datasource in the order
+// of the result schema, their data types indexed by name, and the flattened fields
+func flattenLqlDatasourceFields(resultSchema []api.DatasourceSchema) ([]string, map[string]string, []map[string]interface{}) {
+	var (
+		names  = make([]string, 0, len(resultSchema))
+		types  = make(map[string]string, len(resultSchema))
+		fields = make([]map[string]interface{}, 0, len(resultSchema))
+	)
+	for _, field := range resultSchema {
+		names = append(names, field.Name)
+		types[field.Name] = field.DataType
+		fields = append(fields, map[string]interface{}{
+			"name":        field.Name,
+			"data_type":   field.DataType,
+			"description": field.Description,
+		})
+	}
+	return names, types, fields
+}
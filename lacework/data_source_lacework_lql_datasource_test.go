@@ -0,0 +1,21 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenLqlDatasourceFields(t *testing.T) {
+	names, types, fields := flattenLqlDatasourceFields([]api.DatasourceSchema{
+		{Name: "RESOURCE_ID", DataType: "String", Description: "The id of the resource"},
+		{Name: "RESOURCE_CONFIG", DataType: "JSON"},
+	})
+
+	assert.Equal(t, []string{"RESOURCE_ID", "RESOURCE_CONFIG"}, names)
+	assert.Equal(t, map[string]string{"RESOURCE_ID": "String", "RESOURCE_CONFIG": "JSON"}, types)
+	if assert.Len(t, fields, 2) {
+		assert.Equal(t, "The id of the resource", fields[0]["description"])
+	}
+}
@@ -0,0 +1,89 @@
+package lacework
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLaceworkLqlDatasources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkLqlDatasourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return datasources whose name starts with the provided prefix (i.e. LW_CFG_AWS_)",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of all datasources that matched the filter",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"datasources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The datasources that matched the filter",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkLqlDatasourcesRead(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework   = meta.(*providerMeta).client
+		namePrefix = d.Get("name_prefix").(string)
+	)
+
+	log.Printf("[INFO] Listing LQL Datasources with prefix '%s'\n", namePrefix)
+	response, err := lacework.V2.Datasources.List()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(response.Data, func(i, j int) bool {
+		return response.Data[i].Name < response.Data[j].Name
+	})
+
+	var (
+		names       = make([]string, 0)
+		datasources = make([]map[string]interface{}, 0)
+	)
+	for _, datasource := range response.Data {
+		if !strings.HasPrefix(datasource.Name, namePrefix) {
+			continue
+		}
+
+		names = append(names, datasource.Name)
+		datasources = append(datasources, map[string]interface{}{
+			"name":        datasource.Name,
+			"description": datasource.Description,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("names", names)
+	d.Set("datasources", datasources)
+
+	log.Printf("[INFO] Found %d LQL Datasources matching the filter\n", len(names))
+	return nil
+}
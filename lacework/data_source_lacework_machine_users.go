@@ -0,0 +1,114 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkMachineUsers() *schema.Resource {
+	resourceSchema := entitySearchSchema("users", "machine users", map[string]*schema.Schema{
+		"username": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"uid": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"mid": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"primary_group_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"other_group_names": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"created_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	})
+	resourceSchema["usernames"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The sorted and unique names of the machine users that matched the filters",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLaceworkMachineUsersRead,
+		Schema: resourceSchema,
+	}
+}
+
+func dataSourceLaceworkMachineUsersRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	filter, err := expandEntitySearchFilter(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Searching machine users with filters %v\n", filter.Filters)
+	var (
+		response api.UsersEntityResponse
+		entities []api.UserEntity
+	)
+	err = searchAllPages(lacework, lacework.V2.Entities.Search, &response, filter, func() {
+		entities = append(entities, response.Data...)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to search machine users: %s", err)
+	}
+
+	users, usernames := flattenUserEntities(entities)
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("usernames", usernames)
+	d.Set("users", users)
+
+	log.Printf("[INFO] Found %d machine users matching the filters\n", len(users))
+	return nil
+}
+
+// flattenUserEntities flattens the machine users and returns their sorted and unique names
+func flattenUserEntities(entities []api.UserEntity) ([]map[string]interface{}, []string) {
+	var (
+		users     = make([]map[string]interface{}, 0, len(entities))
+		usernames = make([]string, 0, len(entities))
+		seen      = map[string]bool{}
+	)
+
+	for _, entity := range entities {
+		users = append(users, map[string]interface{}{
+			"username":           entity.Username,
+			"uid":                entity.UID,
+			"mid":                entity.Mid,
+			"primary_group_name": entity.PrimaryGroupName,
+			"other_group_names":  entity.OtherGroupNames,
+			"created_time":       formatSearchTime(entity.CreatedTime),
+		})
+
+		if entity.Username != "" && !seen[entity.Username] {
+			seen[entity.Username] = true
+			usernames = append(usernames, entity.Username)
+		}
+	}
+	sort.Strings(usernames)
+	return users, usernames
+}
@@ -0,0 +1,216 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkMachines() *schema.Resource {
+	resourceSchema := entitySearchSchema("machines", "machines", map[string]*schema.Schema{
+		"mid": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"hostname": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"aws_instance_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"aws_zone": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"primary_ip_addr": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"start_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"end_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"domain": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"os": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"os_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"kernel": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"kernel_release": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"kernel_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"agent_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"agent_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"agent_mode": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"agent_last_update": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	})
+	resourceSchema["hostnames"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The sorted and unique hostnames of the machines that matched the filters",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLaceworkMachinesRead,
+		Schema: resourceSchema,
+	}
+}
+
+func dataSourceLaceworkMachinesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	filter, err := expandEntitySearchFilter(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Searching machines with filters %v\n", filter.Filters)
+	response, err := lacework.V2.Entities.ListAllMachinesWithFilters(filter)
+	if err != nil {
+		return fmt.Errorf("unable to search machines: %s", err)
+	}
+
+	var (
+		details = map[int]api.MachineDetailEntity{}
+		agents  = map[int]api.AgentInfo{}
+		mids    = make([]int, 0, len(response.Data))
+	)
+	for _, machine := range response.Data {
+		mids = append(mids, machine.Mid)
+	}
+
+	// the details and the agents of the machines are searched with their machine
+	// ids, the filters of the data source only apply to the machines
+	if len(mids) != 0 {
+		midsSearch := api.SearchFilter{
+			TimeFilter: filter.TimeFilter,
+			Filters:    []api.Filter{midsFilter(mids)},
+		}
+
+		detailsResponse, err := lacework.V2.Entities.ListAllMachineDetailsWithFilters(midsSearch)
+		if err != nil {
+			return fmt.Errorf("unable to search the details of the machines: %s", err)
+		}
+		for _, detail := range detailsResponse.Data {
+			details[detail.Mid] = detail
+		}
+
+		var agentsResponse api.AgentInfoResponse
+		err = searchAllPages(lacework, lacework.V2.AgentInfo.Search, &agentsResponse, midsSearch, func() {
+			for _, agent := range agentsResponse.Data {
+				agents[agent.Mid] = agent
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("unable to search the agents of the machines: %s", err)
+		}
+	}
+
+	machines, hostnames, err := flattenMachineEntities(response.Data, details, agents)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("hostnames", hostnames)
+	d.Set("machines", machines)
+
+	log.Printf("[INFO] Found %d machines matching the filters\n", len(machines))
+	return nil
+}
+
+// flattenMachineEntities flattens the machines with their details and agents, and
+// returns their sorted and unique hostnames
+func flattenMachineEntities(entities []api.MachineEntity, details map[int]api.MachineDetailEntity,
+	agents map[int]api.AgentInfo) ([]map[string]interface{}, []string, error) {
+	var (
+		machines  = make([]map[string]interface{}, 0, len(entities))
+		hostnames = make([]string, 0, len(entities))
+		seen      = map[string]bool{}
+	)
+
+	for _, entity := range entities {
+		tags, err := flattenEntityTags(entity.Tags)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read the tags of machine %d: %s", entity.Mid, err)
+		}
+
+		detail := details[entity.Mid]
+		agent := agents[entity.Mid]
+		machines = append(machines, map[string]interface{}{
+			"mid":               entity.Mid,
+			"hostname":          entity.Hostname,
+			"aws_instance_id":   entity.AwsInstanceID,
+			"aws_zone":          detail.AwsZone,
+			"primary_ip_addr":   entity.PrimaryIpAddr,
+			"start_time":        formatSearchTime(entity.StartTime),
+			"end_time":          formatSearchTime(entity.EndTime),
+			"tags":              tags,
+			"domain":            detail.Domain,
+			"os":                detail.Os,
+			"os_version":        detail.OsVersion,
+			"kernel":            detail.Kernel,
+			"kernel_release":    detail.KernelRelease,
+			"kernel_version":    detail.KernelVersion,
+			"agent_version":     agent.AgentVersion,
+			"agent_status":      agent.Status,
+			"agent_mode":        agent.Mode,
+			"agent_last_update": formatSearchTime(agent.LastUpdate),
+		})
+
+		if entity.Hostname != "" && !seen[entity.Hostname] {
+			seen[entity.Hostname] = true
+			hostnames = append(hostnames, entity.Hostname)
+		}
+	}
+	sort.Strings(hostnames)
+	return machines, hostnames, nil
+}
@@ -26,7 +26,7 @@ func dataSourceLaceworkMetricModule() *schema.Resource {
 
 func dataLaceworkMetricModuleRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		name          = d.Get("name").(string)
 		moduleVersion = d.Get("version").(string)
 	)
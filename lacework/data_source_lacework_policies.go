@@ -0,0 +1,314 @@
+package lacework
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func dataSourceLaceworkPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only return policies that have all of the provided tags",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"severities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only return policies with one of the provided severities",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: ValidSeverity(),
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return policies that are enabled (true) or disabled (false)",
+			},
+			"policy_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: fmt.Sprintf("Only return policies of the provided type. Valid types are: %s",
+					strings.Join(validPolicyTypes(), ", ")),
+				ValidateFunc: validation.StringInSlice(validPolicyTypes(), false),
+			},
+			"id_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return policies whose id starts with the provided prefix (i.e. lacework-global-)",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The ids of all policies that matched the filters",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The policies that matched the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"query_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remediation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"evaluation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"alert_profile": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exception_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"data_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"multi_value": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// policiesFilter holds the optional filters of the lacework_policies data source,
+// a nil pointer or an empty value means the filter was not provided
+type policiesFilter struct {
+	tags       []string
+	severities []string
+	enabled    *bool
+	policyType string
+	idPrefix   string
+}
+
+func (f policiesFilter) match(policy api.Policy) bool {
+	if f.idPrefix != "" && !strings.HasPrefix(policy.PolicyID, f.idPrefix) {
+		return false
+	}
+
+	if f.policyType != "" && policy.PolicyType != f.policyType {
+		return false
+	}
+
+	if f.enabled != nil && policy.Enabled != *f.enabled {
+		return false
+	}
+
+	if len(f.severities) != 0 && !ContainsStr(f.severities, strings.ToLower(policy.Severity)) {
+		return false
+	}
+
+	for _, tag := range f.tags {
+		if !policy.HasTag(tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dataSourceLaceworkPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	filter := policiesFilter{
+		tags:       castStringSlice(d.Get("tags").(*schema.Set).List()),
+		severities: castAndTransformStringSlice(d.Get("severities").(*schema.Set).List(), strings.ToLower),
+		policyType: d.Get("policy_type").(string),
+		idPrefix:   d.Get("id_prefix").(string),
+	}
+
+	// the enabled filter is a tri-state, an unset attribute must not filter disabled policies
+	if !d.GetRawConfig().GetAttr("enabled").IsNull() {
+		enabled := d.Get("enabled").(bool)
+		filter.enabled = &enabled
+	}
+
+	if len(filter.tags) != 0 {
+		if err := validatePolicyTagsExist(lacework, filter.tags); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Listing Policies with filter: %+v\n", filter)
+	response, err := lacework.V2.Policy.List()
+	if err != nil {
+		return err
+	}
+
+	var (
+		ids      = make([]string, 0)
+		policies = make([]map[string]interface{}, 0)
+	)
+
+	sort.Slice(response.Data, func(i, j int) bool {
+		return response.Data[i].PolicyID < response.Data[j].PolicyID
+	})
+
+	for _, policy := range response.Data {
+		if !filter.match(policy) {
+			continue
+		}
+
+		ids = append(ids, policy.PolicyID)
+		policies = append(policies, flattenPolicy(policy))
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("ids", ids)
+	d.Set("policies", policies)
+
+	log.Printf("[INFO] Found %d Policies matching the filter\n", len(ids))
+	return nil
+}
+
+// validatePolicyTagsExist verifies that every provided tag is known by the
+// Lacework platform, filtering by a misspelled tag would otherwise silently
+// return an empty list of policies
+func validatePolicyTagsExist(lacework *api.Client, tags []string) error {
+	response, err := lacework.V2.Policy.ListTags()
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	for _, tag := range tags {
+		if !ContainsStr(response.Data, tag) {
+			unknown = append(unknown, tag)
+		}
+	}
+
+	if len(unknown) != 0 {
+		return fmt.Errorf("unknown policy tag(s): %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+func flattenPolicy(policy api.Policy) map[string]interface{} {
+	var constraints []map[string]interface{}
+	for _, key := range sortedKeys(policy.ExceptionConfiguration) {
+		for _, field := range policy.ExceptionConfiguration[key] {
+			constraints = append(constraints, map[string]interface{}{
+				"field_key":   field.FieldKey,
+				"data_type":   field.DataType,
+				"multi_value": field.MultiValue,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                      policy.PolicyID,
+		"type":                    policy.PolicyType,
+		"query_id":                policy.QueryID,
+		"title":                   policy.Title,
+		"enabled":                 policy.Enabled,
+		"description":             policy.Description,
+		"remediation":             policy.Remediation,
+		"severity":                policy.Severity,
+		"limit":                   policy.Limit,
+		"evaluation":              policy.EvalFrequency,
+		"alert_enabled":           policy.AlertEnabled,
+		"alert_profile":           policy.AlertProfile,
+		"tags":                    policy.Tags,
+		"owner":                   policy.Owner,
+		"updated_time":            policy.LastUpdateTime,
+		"updated_by":              policy.LastUpdateUser,
+		"exception_configuration": constraints,
+	}
+}
+
+func validPolicyTypes() []string {
+	return []string{
+		api.PolicyTypeCompliance.String(),
+		api.PolicyTypeManual.String(),
+		api.PolicyTypeViolation.String(),
+	}
+}
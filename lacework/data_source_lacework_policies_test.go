@@ -0,0 +1,34 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoliciesFilterMatch(t *testing.T) {
+	var (
+		enabled  = true
+		disabled = false
+		policy   = api.Policy{
+			PolicyID:   "lacework-global-31",
+			PolicyType: "Compliance",
+			Enabled:    true,
+			Severity:   "High",
+			Tags:       []string{"domain:AWS", "framework:cis-aws-1-4-0"},
+		}
+	)
+
+	assert.True(t, policiesFilter{}.match(policy))
+	assert.True(t, policiesFilter{idPrefix: "lacework-global-"}.match(policy))
+	assert.False(t, policiesFilter{idPrefix: "custom-"}.match(policy))
+	assert.True(t, policiesFilter{policyType: "Compliance"}.match(policy))
+	assert.False(t, policiesFilter{policyType: "Violation"}.match(policy))
+	assert.True(t, policiesFilter{enabled: &enabled}.match(policy))
+	assert.False(t, policiesFilter{enabled: &disabled}.match(policy))
+	assert.True(t, policiesFilter{severities: []string{"critical", "high"}}.match(policy))
+	assert.False(t, policiesFilter{severities: []string{"low"}}.match(policy))
+	assert.True(t, policiesFilter{tags: []string{"domain:AWS", "framework:cis-aws-1-4-0"}}.match(policy))
+	assert.False(t, policiesFilter{tags: []string{"domain:AWS", "domain:GCP"}}.match(policy))
+}
@@ -0,0 +1,235 @@
+package lacework
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/lacework/go-sdk/v2/lwtime"
+)
+
+func dataSourceLaceworkQueryResult() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaceworkQueryResultRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The text of the LQL query to execute",
+				ExactlyOneOf: []string{"query", "query_id"},
+			},
+			"query_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The id of an existing LQL query to execute",
+				ExactlyOneOf: []string{"query", "query_id"},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "-24h",
+				Description: "The start of the time window, either an RFC3339 time or a relative " +
+					"time specifier like -24h or -7d@d",
+				ValidateDiagFunc: ValidTimeWindowValue(),
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "now",
+				Description: "The end of the time window, either an RFC3339 time or a relative " +
+					"time specifier like now or -1h",
+				ValidateDiagFunc: ValidTimeWindowValue(),
+			},
+			"arguments": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The arguments of the query, the time window is set with start_time and end_time",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateFunc: validateQueryResultArguments,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of rows to return",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"rows_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON encoded rows returned by the query",
+			},
+			"rows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The rows returned by the query, nested objects and lists " +
+					"are JSON encoded and numbers are returned as strings",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			"row_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of rows returned by the query",
+			},
+		},
+	}
+}
+
+func dataSourceLaceworkQueryResultRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	start, end, err := getTimeWindow(d, "start_time", "end_time")
+	if err != nil {
+		return err
+	}
+
+	arguments := []api.ExecuteQueryArgument{
+		{Name: api.QueryStartTimeRange, Value: start.UTC().Format(lwtime.RFC3339Milli)},
+		{Name: api.QueryEndTimeRange, Value: end.UTC().Format(lwtime.RFC3339Milli)},
+	}
+	queryArguments := d.Get("arguments").(map[string]interface{})
+	for _, name := range sortedKeys(queryArguments) {
+		arguments = append(arguments, api.ExecuteQueryArgument{
+			Name:  api.ExecuteQueryArgumentName(name),
+			Value: queryArguments[name].(string),
+		})
+	}
+
+	var options api.ExecuteQueryOptions
+	if limit, ok := d.GetOk("limit"); ok {
+		l := limit.(int)
+		options.Limit = &l
+	}
+
+	// the request is built before the query runs in the background, so the goroutine
+	// never reads the resource data once the read returns
+	var execute func() (api.ExecuteQueryResponse, error)
+	if queryID, ok := d.GetOk("query_id"); ok {
+		request := api.ExecuteQueryByIDRequest{
+			QueryID:   queryID.(string),
+			Options:   options,
+			Arguments: arguments,
+		}
+		execute = func() (api.ExecuteQueryResponse, error) {
+			log.Printf("[INFO] Executing Query with id %s from %s to %s\n", request.QueryID, start, end)
+			return lacework.V2.Query.ExecuteByID(request)
+		}
+	} else {
+		request := api.ExecuteQueryRequest{
+			Query:     api.ExecuteQuery{QueryText: d.Get("query").(string)},
+			Options:   options,
+			Arguments: arguments,
+		}
+		execute = func() (api.ExecuteQueryResponse, error) {
+			log.Printf("[INFO] Executing Query from %s to %s\n", start, end)
+			return lacework.V2.Query.Execute(request)
+		}
+	}
+
+	type result struct {
+		response api.ExecuteQueryResponse
+		err      error
+	}
+
+	// the go-sdk requests don't support contexts, the query runs in the background and
+	// the data source fails once the read timeout expires. The channel is buffered so
+	// the goroutine always completes, at the latest when the request of the client times out
+	done := make(chan result, 1)
+	go func() {
+		var r result
+		r.response, r.err = execute()
+		done <- r
+	}()
+
+	timeout := time.NewTimer(d.Timeout(schema.TimeoutRead))
+	defer timeout.Stop()
+
+	var r result
+	select {
+	case r = <-done:
+	case <-timeout.C:
+		return fmt.Errorf("timeout while executing query after %s", d.Timeout(schema.TimeoutRead))
+	}
+	if r.err != nil {
+		return fmt.Errorf("unable to execute query: %s", r.err)
+	}
+
+	rowsJSON, err := json.Marshal(r.response.Data)
+	if err != nil {
+		return fmt.Errorf("unable to encode the query results: %s", err)
+	}
+
+	rows, err := flattenQueryResultRows(r.response.Data)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("rows_json", string(rowsJSON))
+	d.Set("rows", rows)
+	d.Set("row_count", len(rows))
+
+	log.Printf("[INFO] Query returned %d rows\n", len(rows))
+	return nil
+}
+
+// flattenQueryResultRows converts the rows returned by a query into maps of strings,
+// nested objects and lists are JSON encoded
+func flattenQueryResultRows(data api.ExecuteQueryData) ([]map[string]string, error) {
+	rows := make([]map[string]string, 0, len(data))
+	for _, r := range data {
+		row, ok := r.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected query result row: %v", r)
+		}
+
+		flat := make(map[string]string, len(row))
+		for key, value := range row {
+			switch v := value.(type) {
+			case nil:
+				flat[key] = ""
+			case string:
+				flat[key] = v
+			case json.Number:
+				flat[key] = v.String()
+			case bool:
+				flat[key] = fmt.Sprint(v)
+			default:
+				bytes, err := json.Marshal(v)
+				if err != nil {
+					return nil, fmt.Errorf("unable to encode the query result column %s: %s", key, err)
+				}
+				flat[key] = string(bytes)
+			}
+		}
+		rows = append(rows, flat)
+	}
+	return rows, nil
+}
+
+func validateQueryResultArguments(value interface{}, key string) ([]string, []error) {
+	for name := range value.(map[string]interface{}) {
+		if name == string(api.QueryStartTimeRange) || name == string(api.QueryEndTimeRange) {
+			return nil, []error{
+				fmt.Errorf("%s: the %s argument is set with start_time and end_time", key, name),
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,41 @@
+package lacework
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenQueryResultRows(t *testing.T) {
+	rows, err := flattenQueryResultRows(api.ExecuteQueryData{
+		map[string]interface{}{
+			"RESOURCE_ID": "arn:aws:s3:::bucket",
+			"COUNT":       json.Number("42"),
+			"PUBLIC":      true,
+			"TAGS":        map[string]interface{}{"env": "prod"},
+			"DELETED":     nil,
+		},
+	})
+	if assert.NoError(t, err) && assert.Len(t, rows, 1) {
+		assert.Equal(t, map[string]string{
+			"RESOURCE_ID": "arn:aws:s3:::bucket",
+			"COUNT":       "42",
+			"PUBLIC":      "true",
+			"TAGS":        `{"env":"prod"}`,
+			"DELETED":     "",
+		}, rows[0])
+	}
+
+	_, err = flattenQueryResultRows(api.ExecuteQueryData{"not a row"})
+	assert.Error(t, err)
+}
+
+func TestValidateQueryResultArguments(t *testing.T) {
+	_, errs := validateQueryResultArguments(map[string]interface{}{"Region": "us-west-2"}, "arguments")
+	assert.Empty(t, errs)
+
+	_, errs = validateQueryResultArguments(map[string]interface{}{"StartTimeRange": "x"}, "arguments")
+	assert.Len(t, errs, 1)
+}
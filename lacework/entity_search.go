@@ -0,0 +1,122 @@
+package lacework
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// entitySearchSchema returns the schema shared by the entity data sources, merged with
+// the schema of the entities returned by the data source in the provided attribute
+func entitySearchSchema(attribute, entities string, entity map[string]*schema.Schema) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "-24h",
+			Description: "The start of the time window, either an RFC3339 time or a relative " +
+				"time specifier like -24h or -7d@d",
+			ValidateDiagFunc: ValidTimeWindowValue(),
+		},
+		"end_time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "now",
+			Description: "The end of the time window, either an RFC3339 time or a relative " +
+				"time specifier like now or -1h",
+			ValidateDiagFunc: ValidTimeWindowValue(),
+		},
+		"filter": searchFilterSchema(
+			fmt.Sprintf("The filters that the %s must match, all filters must match", entities),
+			"The field to filter on, i.e. mid",
+		),
+		attribute: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("The %s that matched the filters", entities),
+			Elem: &schema.Resource{
+				Schema: entity,
+			},
+		},
+	}
+}
+
+// expandEntitySearchFilter builds the search filter of the entity data sources
+func expandEntitySearchFilter(d *schema.ResourceData) (api.SearchFilter, error) {
+	start, end, err := getTimeWindow(d, "start_time", "end_time")
+	if err != nil {
+		return api.SearchFilter{}, err
+	}
+
+	search := api.SearchFilter{
+		TimeFilter: &api.TimeFilter{StartTime: &start, EndTime: &end},
+	}
+	search.Filters, err = expandSearchFilters(d)
+	return search, err
+}
+
+// searchAllPages runs a search and calls collect after reading each page of the
+// response, for the endpoints without a function to list all the pages
+func searchAllPages(lacework *api.Client, search func(interface{}, api.SearchFilter) error,
+	response api.Pageable, filter api.SearchFilter, collect func()) error {
+	if err := search(response, filter); err != nil {
+		return err
+	}
+
+	for {
+		collect()
+
+		pageOk, err := lacework.NextPage(response)
+		if err != nil {
+			return err
+		}
+		if !pageOk {
+			return nil
+		}
+	}
+}
+
+// midsFilter returns the filter that matches the provided machine ids
+func midsFilter(mids []int) api.Filter {
+	values := make([]string, 0, len(mids))
+	for _, mid := range mids {
+		values = append(values, strconv.Itoa(mid))
+	}
+	return api.Filter{Field: "mid", Expression: "in", Values: values}
+}
+
+// flattenEntityTags flattens the tags of the entities, the values that are not strings
+// are JSON encoded and the empty values are removed
+func flattenEntityTags(tags interface{}) (map[string]string, error) {
+	raw, err := json.Marshal(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, err
+	}
+
+	flattened := make(map[string]string, len(decoded))
+	for key, value := range decoded {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			if v != "" {
+				flattened[key] = v
+			}
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			flattened[key] = string(encoded)
+		}
+	}
+	return flattened, nil
+}
@@ -0,0 +1,89 @@
+package lacework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandEntitySearchFilter(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceLaceworkMachines().Schema, map[string]interface{}{
+		"start_time": "2026-01-01T00:00:00Z",
+		"filter": []interface{}{
+			map[string]interface{}{"field": "machineTags.Env", "expression": "eq", "value": "production"},
+		},
+	})
+
+	filter, err := expandEntitySearchFilter(d)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2026-01-01T00:00:00Z", filter.TimeFilter.StartTime.Format(time.RFC3339))
+		assert.NotNil(t, filter.TimeFilter.EndTime)
+		assert.Equal(t, []api.Filter{
+			{Field: "machineTags.Env", Expression: "eq", Value: "production", Values: []string{}},
+		}, filter.Filters)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceLaceworkContainers().Schema, map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"field": "mid", "expression": "in"}},
+	})
+	_, err = expandEntitySearchFilter(d)
+	assert.EqualError(t, err, "filter.0: the in expression requires values")
+}
+
+func TestMidsFilter(t *testing.T) {
+	assert.Equal(t,
+		api.Filter{Field: "mid", Expression: "in", Values: []string{"1", "42"}},
+		midsFilter([]int{1, 42}),
+	)
+}
+
+func TestFlattenEntityTags(t *testing.T) {
+	tags, err := flattenEntityTags(map[string]interface{}{
+		"Env":    "production",
+		"Empty":  "",
+		"Count":  3,
+		"Labels": map[string]interface{}{"app": "web"},
+		"Null":   nil,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]string{
+			"Env":    "production",
+			"Count":  "3",
+			"Labels": `{"app":"web"}`,
+		}, tags)
+	}
+
+	tags, err = flattenEntityTags(nil)
+	if assert.NoError(t, err) {
+		assert.Empty(t, tags)
+	}
+}
+
+func TestFlattenMachineEntities(t *testing.T) {
+	var machine api.MachineEntity
+	machine.Mid = 42
+	machine.Hostname = "web-1"
+	machine.Tags.Env = "production"
+	machine.Tags.Account = "123456789012"
+
+	machines, hostnames, err := flattenMachineEntities(
+		[]api.MachineEntity{machine, {Mid: 7, Hostname: "db-1"}, {Mid: 42, Hostname: "web-1"}},
+		map[int]api.MachineDetailEntity{42: {Mid: 42, Os: "Linux", KernelRelease: "6.1.0"}},
+		map[int]api.AgentInfo{42: {Mid: 42, AgentVersion: "7.1.0", Status: "ACTIVE"}},
+	)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"db-1", "web-1"}, hostnames)
+		if assert.Len(t, machines, 3) {
+			assert.Equal(t, map[string]string{"Env": "production", "Account": "123456789012"}, machines[0]["tags"])
+			assert.Equal(t, "Linux", machines[0]["os"])
+			assert.Equal(t, "6.1.0", machines[0]["kernel_release"])
+			assert.Equal(t, "7.1.0", machines[0]["agent_version"])
+			assert.Equal(t, "ACTIVE", machines[0]["agent_status"])
+			assert.Equal(t, "", machines[1]["agent_version"])
+			assert.Equal(t, "", machines[1]["agent_last_update"])
+		}
+	}
+}
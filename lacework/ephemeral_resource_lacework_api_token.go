@@ -0,0 +1,100 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ephemeralLaceworkApiToken generates API access tokens that are never stored in the plan
+// or the state, unlike the lacework_api_token data source
+type ephemeralLaceworkApiToken struct {
+	lacework *providerMeta
+}
+
+type ephemeralLaceworkApiTokenModel struct {
+	ExpirySeconds types.Int64  `tfsdk:"expiry_seconds"`
+	Token         types.String `tfsdk:"token"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &ephemeralLaceworkApiToken{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &ephemeralLaceworkApiToken{}
+)
+
+func newEphemeralLaceworkApiToken() ephemeral.EphemeralResource {
+	return &ephemeralLaceworkApiToken{}
+}
+
+func (r *ephemeralLaceworkApiToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *ephemeralLaceworkApiToken) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a Lacework API access token that is never stored in the plan or the state",
+		Attributes: map[string]schema.Attribute{
+			"expiry_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of seconds the API access token is valid for, defaults to 3600",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API access token",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the API access token expires",
+			},
+		},
+	}
+}
+
+func (r *ephemeralLaceworkApiToken) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if lacework, ok := req.ProviderData.(*providerMeta); ok {
+		r.lacework = lacework
+	}
+}
+
+func (r *ephemeralLaceworkApiToken) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config ephemeralLaceworkApiTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ExpirySeconds.IsNull() || config.ExpirySeconds.IsUnknown() {
+		return
+	}
+
+	if expiry := config.ExpirySeconds.ValueInt64(); expiry < 1 || expiry > 86400 {
+		resp.Diagnostics.AddAttributeError(path.Root("expiry_seconds"), "Invalid expiry_seconds",
+			fmt.Sprintf("expected expiry_seconds to be in the range (1 - 86400), got %d", expiry))
+	}
+}
+
+func (r *ephemeralLaceworkApiToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.lacework == nil {
+		resp.Diagnostics.AddError("Unable to generate API access token", providerMisconfiguredErrorMessage())
+		return
+	}
+
+	var config ephemeralLaceworkApiTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := generateApiToken(r.lacework, int(config.ExpirySeconds.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to generate API access token", err.Error())
+		return
+	}
+
+	config.Token = types.StringValue(response.Token)
+	config.ExpiresAt = types.StringValue(response.ExpiresAt.UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
@@ -0,0 +1,717 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// GenerateOptions are the options of the generate command of the provider binary
+type GenerateOptions struct {
+	// Dir is the directory where the .tf files are written
+	Dir string
+	// Force overwrites the .tf files that already exist in Dir
+	Force bool
+	// ResourceTypes limits the generated resources to the provided types, all
+	// supported resource types are generated when empty
+	ResourceTypes []string
+
+	// the provider configuration, unset values fall back to the environment
+	// variables and the Lacework configuration file like the provider does
+	Profile      string
+	Account      string
+	Subaccount   string
+	Organization bool
+
+	// Warnings receives the objects that couldn't be generated
+	Warnings io.Writer
+}
+
+// generatedObject is an object of a Lacework account that a resource is generated for
+type generatedObject struct {
+	resourceType string
+	name         string
+	importID     string
+}
+
+// generatedVariable is a variable that replaces a secret that the Lacework API masks
+type generatedVariable struct {
+	name        string
+	description string
+}
+
+// generatedResource is the resource and import blocks generated for an object
+type generatedResource struct {
+	generatedObject
+	address   string
+	body      string
+	variables []generatedVariable
+}
+
+// generateListers list the objects of every resource type supported by the generate command
+var generateListers = []func(*api.Client) ([]generatedObject, error){
+	listGenerateAlertChannels,
+	listGenerateAlertRules,
+	listGenerateReportRules,
+	listGenerateResourceGroups,
+	listGenerateCloudAccounts,
+	listGenerateContainerRegistries,
+	listGenerateQueries,
+	listGeneratePolicies,
+	listGeneratePolicyExceptions,
+	listGenerateVulnerabilityExceptions,
+}
+
+// Generate writes .tf files with the resources of an existing Lacework account, every
+// resource comes with an import block to bring it under management with Terraform 1.5+
+func Generate(ctx context.Context, opts GenerateOptions) error {
+	if opts.Warnings == nil {
+		opts.Warnings = os.Stderr
+	}
+
+	provider := Provider()
+	for _, resourceType := range opts.ResourceTypes {
+		if _, ok := provider.ResourcesMap[resourceType]; !ok {
+			return fmt.Errorf("unknown resource type '%s'", resourceType)
+		}
+	}
+
+	lacework, err := generateClient(ctx, provider, opts)
+	if err != nil {
+		return err
+	}
+
+	var objects []generatedObject
+	for _, list := range generateListers {
+		found, err := list(lacework.client)
+		if err != nil {
+			return err
+		}
+		for _, object := range found {
+			if len(opts.ResourceTypes) == 0 || ContainsStr(opts.ResourceTypes, object.resourceType) {
+				objects = append(objects, object)
+			}
+		}
+	}
+
+	var (
+		files     = map[string][]generatedResource{}
+		addresses = map[string]bool{}
+	)
+	for _, object := range objects {
+		resource, err := generateResource(ctx, provider, lacework, object, addresses)
+		if err != nil {
+			fmt.Fprintf(opts.Warnings, "Skipping %s '%s': %s\n", object.resourceType, object.importID, err)
+			continue
+		}
+		files[object.resourceType] = append(files[object.resourceType], resource)
+	}
+
+	return writeGeneratedFiles(opts, files)
+}
+
+// generateClient configures the provider the same way Terraform does, the options that are
+// not provided are read from the environment variables or the Lacework configuration file
+func generateClient(ctx context.Context, provider *schema.Provider, opts GenerateOptions) (*providerMeta, error) {
+	config := map[string]interface{}{}
+	if opts.Profile != "" {
+		config["profile"] = opts.Profile
+	}
+	if opts.Account != "" {
+		config["account"] = opts.Account
+	}
+	if opts.Subaccount != "" {
+		config["subaccount"] = opts.Subaccount
+	}
+	if opts.Organization {
+		config["organization"] = true
+	}
+
+	diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(config))
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return nil, fmt.Errorf("%s %s", d.Summary, d.Detail)
+		}
+	}
+
+	lacework, ok := provider.Meta().(*providerMeta)
+	if !ok || lacework == nil {
+		return nil, fmt.Errorf("unable to create Lacework API client")
+	}
+	return lacework, nil
+}
+
+// generateResource imports and reads the object with the provider resource, then renders
+// the configured attributes of the resource
+func generateResource(ctx context.Context, provider *schema.Provider, lacework *providerMeta,
+	object generatedObject, addresses map[string]bool) (generatedResource, error) {
+	resource, ok := provider.ResourcesMap[object.resourceType]
+	if !ok {
+		return generatedResource{}, fmt.Errorf("unknown resource type")
+	}
+
+	d := resource.Data(nil)
+	d.SetId(object.importID)
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		imported, err := resource.Importer.StateContext(ctx, d, lacework)
+		if err != nil {
+			return generatedResource{}, err
+		}
+		if len(imported) != 1 {
+			return generatedResource{}, fmt.Errorf("unexpected number of imported resources: %d", len(imported))
+		}
+		d = imported[0]
+	}
+
+	var err error
+	switch {
+	case resource.Read != nil:
+		err = resource.Read(d, lacework)
+	case resource.ReadContext != nil:
+		if diags := resource.ReadContext(ctx, d, lacework); diags.HasError() {
+			err = fmt.Errorf("%s", diags[0].Summary)
+		}
+	}
+	if err != nil {
+		return generatedResource{}, err
+	}
+	if d.Id() == "" {
+		return generatedResource{}, fmt.Errorf("not found")
+	}
+
+	name := generatedResourceName(object.resourceType, object.name, addresses)
+	w := &hclWriter{}
+	var variables []generatedVariable
+	w.line("resource %q %q {", object.resourceType, name)
+	w.indent++
+	writeGeneratedBody(w, resource.Schema, d.Get, name, &variables)
+	w.indent--
+	w.line("}")
+
+	return generatedResource{
+		generatedObject: object,
+		address:         fmt.Sprintf("%s.%s", object.resourceType, name),
+		body:            w.String(),
+		variables:       variables,
+	}, nil
+}
+
+var generatedNameRegexp = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// generatedResourceName returns a unique Terraform resource name from the name of the object
+func generatedResourceName(resourceType, objectName string, addresses map[string]bool) string {
+	name := strings.Trim(generatedNameRegexp.ReplaceAllString(strings.ToLower(objectName), "_"), "_")
+	if name == "" {
+		name = "resource"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "r_" + name
+	}
+
+	unique := name
+	for i := 2; addresses[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	addresses[resourceType+"."+unique] = true
+	return unique
+}
+
+// writeGeneratedBody writes the configurable attributes and blocks of a resource, the
+// sensitive attributes are replaced with variables since the Lacework API masks them
+func writeGeneratedBody(w *hclWriter, schemaMap map[string]*schema.Schema,
+	get func(string) interface{}, prefix string, variables *[]generatedVariable) {
+	type attribute struct{ key, value string }
+	type block struct {
+		key    string
+		schema *schema.Resource
+		value  map[string]interface{}
+	}
+
+	var (
+		attributes []attribute
+		blocks     []block
+	)
+	for _, key := range sortedKeys(schemaMap) {
+		s := schemaMap[key]
+		if (s.Computed && !s.Optional) || s.Deprecated != "" {
+			continue
+		}
+
+		value := get(key)
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			for _, item := range generatedListItems(value) {
+				if m, ok := item.(map[string]interface{}); ok {
+					blocks = append(blocks, block{key, elem, m})
+				}
+			}
+			continue
+		}
+
+		if s.Sensitive {
+			if s.Required || !isGeneratedZeroValue(value) {
+				variable := generatedVariable{
+					name:        fmt.Sprintf("%s_%s", prefix, key),
+					description: fmt.Sprintf("The %s of %s", key, strings.ReplaceAll(prefix, "_", " ")),
+				}
+				*variables = append(*variables, variable)
+				attributes = append(attributes, attribute{key, "var." + variable.name})
+			}
+			continue
+		}
+
+		if !s.Required {
+			if s.Default != nil && reflect.DeepEqual(value, s.Default) {
+				continue
+			}
+			if s.Default == nil && isGeneratedZeroValue(value) {
+				continue
+			}
+		}
+		attributes = append(attributes, attribute{key, renderGeneratedValue(s, value, w.indent)})
+	}
+
+	width := 0
+	for _, a := range attributes {
+		if len(a.key) > width {
+			width = len(a.key)
+		}
+	}
+	for _, a := range attributes {
+		w.line("%-*s = %s", width, a.key, a.value)
+	}
+
+	for _, b := range blocks {
+		w.line("")
+		w.line("%s {", b.key)
+		w.indent++
+		item := b.value
+		writeGeneratedBody(w, b.schema.Schema, func(k string) interface{} { return item[k] },
+			prefix+"_"+b.key, variables)
+		w.indent--
+		w.line("}")
+	}
+}
+
+func generatedListItems(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	default:
+		return nil
+	}
+}
+
+func isGeneratedZeroValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case *schema.Set:
+		return v.Len() == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return reflect.ValueOf(value).IsZero()
+	}
+}
+
+// renderGeneratedValue renders a value as an HCL expression
+func renderGeneratedValue(s *schema.Schema, value interface{}, indent int) string {
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		elem, _ := s.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+		items := generatedListItems(value)
+		rendered := make([]string, 0, len(items))
+		for _, item := range items {
+			rendered = append(rendered, renderGeneratedValue(elem, item, indent))
+		}
+		if s.Type == schema.TypeSet {
+			sort.Strings(rendered)
+		}
+		return "[" + strings.Join(rendered, ", ") + "]"
+	case schema.TypeMap:
+		m, _ := value.(map[string]interface{})
+		elem, _ := s.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+		rendered := make([]string, 0, len(m))
+		for _, key := range sortedKeys(m) {
+			rendered = append(rendered, fmt.Sprintf("%s = %s",
+				quoteGeneratedString(key), renderGeneratedValue(elem, m[key], indent)))
+		}
+		return "{ " + strings.Join(rendered, ", ") + " }"
+	case schema.TypeString:
+		str := fmt.Sprint(value)
+		if strings.Contains(strings.TrimRight(str, "\n"), "\n") {
+			return heredocGeneratedString(str, indent)
+		}
+		return quoteGeneratedString(str)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// quoteGeneratedString quotes a string and escapes the HCL template sequences
+func quoteGeneratedString(value string) string {
+	return escapeGeneratedTemplate(strconv.Quote(value))
+}
+
+func heredocGeneratedString(value string, indent int) string {
+	pad := strings.Repeat("  ", indent+1)
+	var b strings.Builder
+	b.WriteString("<<-EOT\n")
+	for _, line := range strings.Split(strings.TrimRight(value, "\n"), "\n") {
+		if line != "" {
+			b.WriteString(pad)
+		}
+		b.WriteString(escapeGeneratedTemplate(line))
+		b.WriteString("\n")
+	}
+	b.WriteString(pad)
+	b.WriteString("EOT")
+	return b.String()
+}
+
+func escapeGeneratedTemplate(value string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(value)
+}
+
+// writeGeneratedFiles writes a file for every resource type, and a variables.tf file
+// with the variables that replace the secrets masked by the Lacework API
+func writeGeneratedFiles(opts GenerateOptions, files map[string][]generatedResource) error {
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return err
+	}
+
+	contents := map[string]string{}
+	var variables []generatedVariable
+	for _, resourceType := range sortedKeys(files) {
+		w := &hclWriter{}
+		for i, resource := range files[resourceType] {
+			if i != 0 {
+				w.line("")
+			}
+			w.line("import {")
+			w.line("  to = %s", resource.address)
+			w.line("  id = %s", quoteGeneratedString(resource.importID))
+			w.line("}")
+			w.line("")
+			w.WriteString(resource.body)
+			variables = append(variables, resource.variables...)
+		}
+		contents[resourceType+".tf"] = w.String()
+	}
+
+	if len(variables) != 0 {
+		w := &hclWriter{}
+		for i, variable := range variables {
+			if i != 0 {
+				w.line("")
+			}
+			w.line("variable %q {", variable.name)
+			w.line("  type        = string")
+			w.line("  sensitive   = true")
+			w.line("  description = %s", quoteGeneratedString(variable.description))
+			w.line("}")
+		}
+		contents["variables.tf"] = w.String()
+	}
+
+	if !opts.Force {
+		for _, file := range sortedKeys(contents) {
+			if fileExist(filepath.Join(opts.Dir, file)) {
+				return fmt.Errorf("file %s already exists, use -force to overwrite it", filepath.Join(opts.Dir, file))
+			}
+		}
+	}
+
+	for _, file := range sortedKeys(contents) {
+		path := filepath.Join(opts.Dir, file)
+		if err := os.WriteFile(path, []byte(contents[file]), 0o644); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Generated %s\n", path)
+	}
+	return nil
+}
+
+// hclWriter writes indented HCL lines
+type hclWriter struct {
+	strings.Builder
+	indent int
+}
+
+func (w *hclWriter) line(format string, args ...interface{}) {
+	line := fmt.Sprintf(format, args...)
+	if line != "" {
+		w.WriteString(strings.Repeat("  ", w.indent))
+	}
+	w.WriteString(line)
+	w.WriteString("\n")
+}
+
+var (
+	generateAlertChannelResources = map[string]string{
+		api.EmailUserAlertChannelType.String():         "lacework_alert_channel_email",
+		api.SlackChannelAlertChannelType.String():      "lacework_alert_channel_slack",
+		api.AwsS3AlertChannelType.String():             "lacework_alert_channel_aws_s3",
+		api.CloudwatchEbAlertChannelType.String():      "lacework_alert_channel_aws_cloudwatch",
+		api.DatadogAlertChannelType.String():           "lacework_alert_channel_datadog",
+		api.WebhookAlertChannelType.String():           "lacework_alert_channel_webhook",
+		api.VictorOpsAlertChannelType.String():         "lacework_alert_channel_victorops",
+		api.CiscoSparkWebhookAlertChannelType.String(): "lacework_alert_channel_cisco_webex",
+		api.MicrosoftTeamsAlertChannelType.String():    "lacework_alert_channel_microsoft_teams",
+		api.GcpPubSubAlertChannelType.String():         "lacework_alert_channel_gcp_pub_sub",
+		api.SplunkHecAlertChannelType.String():         "lacework_alert_channel_splunk",
+		api.ServiceNowRestAlertChannelType.String():    "lacework_alert_channel_service_now",
+		api.NewRelicInsightsAlertChannelType.String():  "lacework_alert_channel_newrelic",
+		api.PagerDutyApiAlertChannelType.String():      "lacework_alert_channel_pagerduty",
+		api.IbmQRadarAlertChannelType.String():         "lacework_alert_channel_qradar",
+	}
+
+	generateCloudAccountResources = map[string]string{
+		api.AwsCfgCloudAccount.String():         "lacework_integration_aws_cfg",
+		api.AwsCtSqsCloudAccount.String():       "lacework_integration_aws_ct",
+		api.AwsEksAuditCloudAccount.String():    "lacework_integration_aws_eks_audit_log",
+		api.AwsSidekickCloudAccount.String():    "lacework_integration_aws_agentless_scanning",
+		api.AwsSidekickOrgCloudAccount.String(): "lacework_integration_aws_org_agentless_scanning",
+		api.AwsUsGovCfgCloudAccount.String():    "lacework_integration_aws_govcloud_cfg",
+		api.AwsUsGovCtSqsCloudAccount.String():  "lacework_integration_aws_govcloud_ct",
+		api.AzureAdAlCloudAccount.String():      "lacework_integration_azure_ad_al",
+		api.AzureAlSeqCloudAccount.String():     "lacework_integration_azure_al",
+		api.AzureCfgCloudAccount.String():       "lacework_integration_azure_cfg",
+		api.AzureSidekickCloudAccount.String():  "lacework_integration_azure_agentless_scanning",
+		api.GcpAtSesCloudAccount.String():       "lacework_integration_gcp_at",
+		api.GcpCfgCloudAccount.String():         "lacework_integration_gcp_cfg",
+		api.GcpGkeAuditCloudAccount.String():    "lacework_integration_gcp_gke_audit_log",
+		api.GcpSidekickCloudAccount.String():    "lacework_integration_gcp_agentless_scanning",
+		api.GcpAlPubSubCloudAccount.String():    "lacework_integration_gcp_pub_sub_audit_log",
+		api.OciCfgCloudAccount.String():         "lacework_integration_oci_cfg",
+		api.AwsDspmCloudAccount.String():        "lacework_integration_aws_dspm",
+		api.AzureDspmCloudAccount.String():      "lacework_integration_azure_dspm",
+	}
+
+	generateContainerRegistryResources = map[string]string{
+		api.GcpGarContainerRegistry.String():        "lacework_integration_gar",
+		api.GhcrContainerRegistry.String():          "lacework_integration_ghcr",
+		api.InlineScannerContainerRegistry.String(): "lacework_integration_inline_scanner",
+		api.ProxyScannerContainerRegistry.String():  "lacework_integration_proxy_scanner",
+		api.AwsEcrContainerRegistry.String():        "lacework_integration_ecr",
+		api.DockerhubContainerRegistry.String():     "lacework_integration_docker_hub",
+		api.DockerhubV2ContainerRegistry.String():   "lacework_integration_docker_v2",
+		api.GcpGcrContainerRegistry.String():        "lacework_integration_gcr",
+	}
+)
+
+func listGenerateAlertChannels(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.AlertChannels.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list alert channels: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, channel := range response.Data {
+		resourceType, ok := generateAlertChannelResources[channel.Type]
+		if channel.Type == api.JiraAlertChannelType.String() {
+			resourceType, ok = "lacework_alert_channel_jira_cloud", true
+			if data, isMap := channel.Data.(map[string]interface{}); isMap && data["jiraType"] == api.JiraServerAlertType {
+				resourceType = "lacework_alert_channel_jira_server"
+			}
+		}
+		if !ok {
+			log.Printf("[WARN] Unsupported alert channel type %s, skipping %s\n", channel.Type, channel.IntgGuid)
+			continue
+		}
+		objects = append(objects, generatedObject{resourceType, channel.Name, channel.IntgGuid})
+	}
+	return objects, nil
+}
+
+func listGenerateAlertRules(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.AlertRules.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list alert rules: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, rule := range response.Data {
+		objects = append(objects, generatedObject{"lacework_alert_rule", rule.Filter.Name, rule.Guid})
+	}
+	return objects, nil
+}
+
+func listGenerateReportRules(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.ReportRules.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list report rules: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, rule := range response.Data {
+		objects = append(objects, generatedObject{"lacework_report_rule", rule.Filter.Name, rule.Guid})
+	}
+	return objects, nil
+}
+
+func listGenerateResourceGroups(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.ResourceGroups.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list resource groups: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, group := range response.Data {
+		// the default resource groups are managed by Lacework
+		if group.IsDefaultBoolean != nil && *group.IsDefaultBoolean {
+			continue
+		}
+		objects = append(objects, generatedObject{"lacework_resource_group", group.Name, group.ResourceGroupGuid})
+	}
+	return objects, nil
+}
+
+func listGenerateCloudAccounts(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.CloudAccounts.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list cloud accounts: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, integration := range response.Data {
+		resourceType, ok := generateCloudAccountResources[integration.Type]
+		if !ok {
+			resourceType = "lacework_integration_cloud_account"
+		}
+		objects = append(objects, generatedObject{resourceType, integration.Name, integration.IntgGuid})
+	}
+	return objects, nil
+}
+
+func listGenerateContainerRegistries(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.ContainerRegistries.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list container registries: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, integration := range response.Data {
+		registryType := integration.ContainerRegistryType().String()
+		resourceType, ok := generateContainerRegistryResources[registryType]
+		if !ok {
+			log.Printf("[WARN] Unsupported container registry type %s, skipping %s\n",
+				registryType, integration.IntgGuid)
+			continue
+		}
+		objects = append(objects, generatedObject{resourceType, integration.Name, integration.IntgGuid})
+	}
+	return objects, nil
+}
+
+func listGenerateQueries(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.Query.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list queries: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, query := range response.Data {
+		// the queries owned by Lacework can't be managed
+		if query.Owner == "Lacework" {
+			continue
+		}
+		objects = append(objects, generatedObject{"lacework_query", query.QueryID, query.QueryID})
+	}
+	return objects, nil
+}
+
+func listGeneratePolicies(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.Policy.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list policies: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, policy := range response.Data {
+		// the policies owned by Lacework can't be managed
+		if policy.Owner == "Lacework" {
+			continue
+		}
+		resourceType := "lacework_policy"
+		if policy.PolicyType == api.PolicyTypeCompliance.String() {
+			resourceType = "lacework_policy_compliance"
+		}
+		objects = append(objects, generatedObject{resourceType, policy.PolicyID, policy.PolicyID})
+	}
+	return objects, nil
+}
+
+func listGeneratePolicyExceptions(lacework *api.Client) ([]generatedObject, error) {
+	policies, err := lacework.V2.Policy.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list policies: %s", err)
+	}
+
+	var objects []generatedObject
+	for _, policy := range policies.Data {
+		// only the policies with an exception configuration support exceptions
+		if len(policy.ExceptionConfiguration) == 0 {
+			continue
+		}
+
+		response, err := lacework.V2.Policy.Exceptions.List(policy.PolicyID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list exceptions of policy %s: %s", policy.PolicyID, err)
+		}
+		for _, exception := range response.Data {
+			objects = append(objects, generatedObject{
+				"lacework_policy_exception",
+				fmt.Sprintf("%s %s", policy.PolicyID, exception.Description),
+				fmt.Sprintf("%s:%s", policy.PolicyID, exception.ExceptionID),
+			})
+		}
+	}
+	return objects, nil
+}
+
+func listGenerateVulnerabilityExceptions(lacework *api.Client) ([]generatedObject, error) {
+	response, err := lacework.V2.VulnerabilityExceptions.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list vulnerability exceptions: %s", err)
+	}
+
+	objects := make([]generatedObject, 0, len(response.Data))
+	for _, exception := range response.Data {
+		switch exception.ExceptionType {
+		case api.VulnerabilityExceptionTypeHost.String():
+			objects = append(objects, generatedObject{
+				"lacework_vulnerability_exception_host", exception.ExceptionName, exception.Guid,
+			})
+		case api.VulnerabilityExceptionTypeContainer.String():
+			objects = append(objects, generatedObject{
+				"lacework_vulnerability_exception_container", exception.ExceptionName, exception.Guid,
+			})
+		}
+	}
+	return objects, nil
+}
@@ -0,0 +1,115 @@
+package lacework
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedResourceName(t *testing.T) {
+	addresses := map[string]bool{}
+	assert.Equal(t, "prod_alerts", generatedResourceName("lacework_alert_channel_slack", "Prod Alerts!", addresses))
+	assert.Equal(t, "prod_alerts_2", generatedResourceName("lacework_alert_channel_slack", "prod-alerts", addresses))
+	assert.Equal(t, "prod_alerts", generatedResourceName("lacework_alert_channel_email", "prod alerts", addresses))
+	assert.Equal(t, "r_123_account", generatedResourceName("lacework_integration_aws_cfg", "123 account", addresses))
+	assert.Equal(t, "resource", generatedResourceName("lacework_integration_aws_cfg", "!!!", addresses))
+}
+
+func TestWriteGeneratedBody(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{
+		"name":    {Type: schema.TypeString, Required: true},
+		"enabled": {Type: schema.TypeBool, Optional: true, Default: true},
+		"tags":    {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"query":   {Type: schema.TypeString, Optional: true},
+		"labels":  {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"guid":    {Type: schema.TypeString, Computed: true},
+		"old":     {Type: schema.TypeString, Optional: true, Deprecated: "use name"},
+		"credentials": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				"client_id":     {Type: schema.TypeString, Required: true},
+				"client_secret": {Type: schema.TypeString, Required: true, Sensitive: true},
+			}},
+		},
+	}}
+
+	d := resource.TestResourceData()
+	d.Set("name", "prod ${env}")
+	d.Set("enabled", false)
+	d.Set("tags", []interface{}{"b", "a"})
+	d.Set("query", "{\n  source { CloudTrailRawEvents }\n}\n")
+	d.Set("labels", map[string]interface{}{"team": "security"})
+	d.Set("guid", "GUID")
+	d.Set("old", "old")
+	d.Set("credentials", []interface{}{map[string]interface{}{
+		"client_id":     "1234",
+		"client_secret": "****",
+	}})
+
+	w := &hclWriter{indent: 1}
+	var variables []generatedVariable
+	writeGeneratedBody(w, resource.Schema, d.Get, "prod", &variables)
+
+	assert.Equal(t, `  enabled = false
+  labels  = { "team" = "security" }
+  name    = "prod $${env}"
+  query   = <<-EOT
+    {
+      source { CloudTrailRawEvents }
+    }
+    EOT
+  tags    = ["a", "b"]
+
+  credentials {
+    client_id     = "1234"
+    client_secret = var.prod_credentials_client_secret
+  }
+`, w.String())
+	assert.Equal(t, []generatedVariable{{
+		name:        "prod_credentials_client_secret",
+		description: "The client_secret of prod credentials",
+	}}, variables)
+}
+
+func TestWriteGeneratedFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string][]generatedResource{
+		"lacework_alert_channel_slack": {{
+			generatedObject: generatedObject{"lacework_alert_channel_slack", "prod", "TECHALLY_GUID"},
+			address:         "lacework_alert_channel_slack.prod",
+			body:            "resource \"lacework_alert_channel_slack\" \"prod\" {\n  slack_url = var.prod_slack_url\n}\n",
+			variables:       []generatedVariable{{"prod_slack_url", "The slack_url of prod"}},
+		}},
+	}
+
+	assert.NoError(t, writeGeneratedFiles(GenerateOptions{Dir: dir}, files))
+
+	content, err := os.ReadFile(filepath.Join(dir, "lacework_alert_channel_slack.tf"))
+	assert.NoError(t, err)
+	assert.Equal(t, `import {
+  to = lacework_alert_channel_slack.prod
+  id = "TECHALLY_GUID"
+}
+
+resource "lacework_alert_channel_slack" "prod" {
+  slack_url = var.prod_slack_url
+}
+`, string(content))
+
+	content, err = os.ReadFile(filepath.Join(dir, "variables.tf"))
+	assert.NoError(t, err)
+	assert.Equal(t, `variable "prod_slack_url" {
+  type        = string
+  sensitive   = true
+  description = "The slack_url of prod"
+}
+`, string(content))
+
+	assert.ErrorContains(t, writeGeneratedFiles(GenerateOptions{Dir: dir}, files), "already exists")
+	assert.NoError(t, writeGeneratedFiles(GenerateOptions{Dir: dir, Force: true}, files))
+}
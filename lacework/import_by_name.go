@@ -0,0 +1,273 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// importByNamePrefix is the prefix of the import ids that reference an object by name,
+// an object can also be referenced with '<type>:<name>' when names collide
+const importByNamePrefix = "name"
+
+// importCandidate is an object that an import id can resolve to
+type importCandidate struct {
+	guid string
+	name string
+	kind string
+}
+
+// parseImportID splits an import id into the type and the name of the referenced object,
+// import ids without a ':' are guids and are returned as is
+func parseImportID(id string) (kind, name string, byName bool) {
+	prefix, name, found := strings.Cut(id, ":")
+	if !found || name == "" {
+		return "", "", false
+	}
+	if prefix == importByNamePrefix {
+		return "", name, true
+	}
+	return prefix, name, true
+}
+
+// resolveImportID returns the guid of the object referenced by the import id, either a guid,
+// 'name:<name>' or '<type>:<name>'. The candidates are only listed when the import id is a
+// name, and the import fails when no candidate or more than one candidate matches.
+func resolveImportID(id, description string, kinds []string,
+	list func() ([]importCandidate, error)) (string, error) {
+	kind, name, byName := parseImportID(id)
+	if !byName {
+		return id, nil
+	}
+
+	if kind != "" && len(kinds) != 0 && !containsFold(kinds, kind) {
+		return "", fmt.Errorf(
+			"unable to import Lacework resource. Type '%s' is not a valid %s type, valid types are: %s",
+			kind, description, strings.Join(kinds, ", "),
+		)
+	}
+
+	candidates, err := list()
+	if err != nil {
+		return "", fmt.Errorf("unable to import Lacework resource. Unable to list %ss: %s", description, err)
+	}
+
+	matches := []importCandidate{}
+	for _, candidate := range candidates {
+		if candidate.name != name {
+			continue
+		}
+		if len(kinds) != 0 && !containsFold(kinds, candidate.kind) {
+			continue
+		}
+		if kind != "" && !strings.EqualFold(candidate.kind, kind) {
+			continue
+		}
+		matches = append(matches, candidate)
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("unable to import Lacework resource. No %s named '%s' was found", description, name)
+	case 1:
+		log.Printf("[INFO] Resolved %s named '%s' to guid: %s\n", description, name, matches[0].guid)
+		return matches[0].guid, nil
+	default:
+		found := make([]string, 0, len(matches))
+		for _, match := range matches {
+			found = append(found, fmt.Sprintf("%s (%s)", match.guid, match.kind))
+		}
+		sort.Strings(found)
+		return "", fmt.Errorf(
+			"unable to import Lacework resource. Found %d %ss named '%s': %s. "+
+				"Import using '<type>:<name>' or the guid instead",
+			len(matches), description, name, strings.Join(found, ", "),
+		)
+	}
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// importLaceworkCloudAccount returns an importer that accepts the guid or the name of a
+// cloud account integration of the provided types, or of any type when no type is provided
+func importLaceworkCloudAccount(kinds ...string) schema.StateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		guid, err := resolveCloudAccountImportID(d.Id(), meta.(*providerMeta).client, kinds)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(guid)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func resolveCloudAccountImportID(id string, lacework *api.Client, kinds []string) (string, error) {
+	return resolveImportID(id, "cloud account integration", kinds, func() ([]importCandidate, error) {
+		response, err := lacework.V2.CloudAccounts.List()
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := make([]importCandidate, 0, len(response.Data))
+		for _, integration := range response.Data {
+			candidates = append(candidates, importCandidate{
+				guid: integration.IntgGuid,
+				name: integration.Name,
+				kind: integration.Type,
+			})
+		}
+		return candidates, nil
+	})
+}
+
+// importLaceworkContainerRegistry returns an importer that accepts the guid or the
+// name of a container registry integration of the provided registry types
+func importLaceworkContainerRegistry(kinds ...string) schema.StateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		guid, err := resolveContainerRegistryImportID(d.Id(), meta.(*providerMeta).client, kinds)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(guid)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func resolveContainerRegistryImportID(id string, lacework *api.Client, kinds []string) (string, error) {
+	return resolveImportID(id, "container registry integration", kinds, func() ([]importCandidate, error) {
+		response, err := lacework.V2.ContainerRegistries.List()
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := make([]importCandidate, 0, len(response.Data))
+		for _, integration := range response.Data {
+			candidates = append(candidates, importCandidate{
+				guid: integration.IntgGuid,
+				name: integration.Name,
+				kind: integration.ContainerRegistryType().String(),
+			})
+		}
+		return candidates, nil
+	})
+}
+
+// importLaceworkAlertChannel returns an importer that accepts the guid or the
+// name of an alert channel of the provided types
+func importLaceworkAlertChannel(kinds ...string) schema.StateContextFunc {
+	return importLaceworkAlertChannelOfJiraType("", kinds...)
+}
+
+// importLaceworkJiraAlertChannel returns an importer that accepts the guid or the name
+// of a Jira alert channel of the provided Jira type, i.e. api.JiraCloudAlertType
+func importLaceworkJiraAlertChannel(jiraType string) schema.StateContextFunc {
+	return importLaceworkAlertChannelOfJiraType(jiraType, api.JiraAlertChannelType.String())
+}
+
+func importLaceworkAlertChannelOfJiraType(jiraType string, kinds ...string) schema.StateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		lacework := meta.(*providerMeta).client
+		guid, err := resolveImportID(d.Id(), "alert channel", kinds, func() ([]importCandidate, error) {
+			response, err := lacework.V2.AlertChannels.List()
+			if err != nil {
+				return nil, err
+			}
+			return alertChannelImportCandidates(response.Data, jiraType), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(guid)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// alertChannelImportCandidates returns the import candidates of the alert channels, the Jira
+// Cloud and Jira Server channels share a type and are told apart by jiraType when it is set
+func alertChannelImportCandidates(channels []api.AlertChannelRaw, jiraType string) []importCandidate {
+	candidates := make([]importCandidate, 0, len(channels))
+	for _, channel := range channels {
+		if jiraType != "" && channel.Type == api.JiraAlertChannelType.String() {
+			data, _ := channel.Data.(map[string]interface{})
+			if data["jiraType"] != jiraType {
+				continue
+			}
+		}
+		candidates = append(candidates, importCandidate{
+			guid: channel.IntgGuid,
+			name: channel.Name,
+			kind: channel.Type,
+		})
+	}
+	return candidates
+}
+
+func resolveResourceGroupImportID(id string, lacework *api.Client) (string, error) {
+	return resolveImportID(id, "resource group", nil, func() ([]importCandidate, error) {
+		response, err := lacework.V2.ResourceGroups.List()
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := make([]importCandidate, 0, len(response.Data))
+		for _, group := range response.Data {
+			candidates = append(candidates, importCandidate{
+				guid: group.ResourceGroupGuid,
+				name: group.Name,
+				kind: group.Type,
+			})
+		}
+		return candidates, nil
+	})
+}
+
+func resolveAlertRuleImportID(id string, lacework *api.Client) (string, error) {
+	return resolveImportID(id, "alert rule", nil, func() ([]importCandidate, error) {
+		response, err := lacework.V2.AlertRules.List()
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := make([]importCandidate, 0, len(response.Data))
+		for _, rule := range response.Data {
+			candidates = append(candidates, importCandidate{
+				guid: rule.Guid,
+				name: rule.Filter.Name,
+				kind: rule.Type,
+			})
+		}
+		return candidates, nil
+	})
+}
+
+func resolveReportRuleImportID(id string, lacework *api.Client) (string, error) {
+	return resolveImportID(id, "report rule", nil, func() ([]importCandidate, error) {
+		response, err := lacework.V2.ReportRules.List()
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := make([]importCandidate, 0, len(response.Data))
+		for _, rule := range response.Data {
+			candidates = append(candidates, importCandidate{
+				guid: rule.Guid,
+				name: rule.Filter.Name,
+				kind: rule.Type,
+			})
+		}
+		return candidates, nil
+	})
+}
@@ -0,0 +1,116 @@
+package lacework
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImportID(t *testing.T) {
+	kind, name, byName := parseImportID("TECHALLY_1234BAE1E42182964D23973F44CFEA3C4AB63B99E9A1EC5")
+	assert.False(t, byName)
+	assert.Empty(t, kind)
+	assert.Empty(t, name)
+
+	kind, name, byName = parseImportID("name:my integration")
+	assert.True(t, byName)
+	assert.Empty(t, kind)
+	assert.Equal(t, "my integration", name)
+
+	kind, name, byName = parseImportID("AwsCfg:prod:us-west-2")
+	assert.True(t, byName)
+	assert.Equal(t, "AwsCfg", kind)
+	assert.Equal(t, "prod:us-west-2", name)
+
+	_, _, byName = parseImportID("name:")
+	assert.False(t, byName)
+}
+
+func TestResolveImportID(t *testing.T) {
+	candidates := []importCandidate{
+		{guid: "GUID_1", name: "prod", kind: "AwsCfg"},
+		{guid: "GUID_2", name: "prod", kind: "AwsCtSqs"},
+		{guid: "GUID_3", name: "dev", kind: "AwsCfg"},
+	}
+	list := func() ([]importCandidate, error) { return candidates, nil }
+
+	t.Run("guid", func(t *testing.T) {
+		guid, err := resolveImportID("GUID_1", "cloud account integration", nil, func() ([]importCandidate, error) {
+			t.Fatal("guids should not be resolved")
+			return nil, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "GUID_1", guid)
+	})
+
+	t.Run("unique name", func(t *testing.T) {
+		guid, err := resolveImportID("name:dev", "cloud account integration", nil, list)
+		assert.NoError(t, err)
+		assert.Equal(t, "GUID_3", guid)
+	})
+
+	t.Run("name restricted to the resource types", func(t *testing.T) {
+		guid, err := resolveImportID("name:prod", "cloud account integration", []string{"AwsCtSqs"}, list)
+		assert.NoError(t, err)
+		assert.Equal(t, "GUID_2", guid)
+	})
+
+	t.Run("ambiguous name", func(t *testing.T) {
+		_, err := resolveImportID("name:prod", "cloud account integration", nil, list)
+		assert.EqualError(t, err, "unable to import Lacework resource. Found 2 cloud account integrations "+
+			"named 'prod': GUID_1 (AwsCfg), GUID_2 (AwsCtSqs). Import using '<type>:<name>' or the guid instead")
+	})
+
+	t.Run("type and name", func(t *testing.T) {
+		guid, err := resolveImportID("awscfg:prod", "cloud account integration", nil, list)
+		assert.NoError(t, err)
+		assert.Equal(t, "GUID_1", guid)
+	})
+
+	t.Run("type of another resource", func(t *testing.T) {
+		_, err := resolveImportID("AwsCfg:prod", "cloud account integration", []string{"AwsCtSqs"}, list)
+		assert.EqualError(t, err, "unable to import Lacework resource. Type 'AwsCfg' is not a valid "+
+			"cloud account integration type, valid types are: AwsCtSqs")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := resolveImportID("name:staging", "cloud account integration", nil, list)
+		assert.EqualError(t, err,
+			"unable to import Lacework resource. No cloud account integration named 'staging' was found")
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		_, err := resolveImportID("name:prod", "alert channel", nil, func() ([]importCandidate, error) {
+			return nil, errors.New("[500] internal error")
+		})
+		assert.EqualError(t, err,
+			"unable to import Lacework resource. Unable to list alert channels: [500] internal error")
+	})
+}
+
+func TestAlertChannelImportCandidates(t *testing.T) {
+	var channels []api.AlertChannelRaw
+	err := json.Unmarshal([]byte(`[
+		{"intgGuid": "GUID_1", "name": "jira", "type": "Jira", "data": {"jiraType": "JIRA_CLOUD"}},
+		{"intgGuid": "GUID_2", "name": "jira", "type": "Jira", "data": {"jiraType": "JIRA_SERVER"}},
+		{"intgGuid": "GUID_3", "name": "jira", "type": "SlackChannel", "data": {}}
+	]`), &channels)
+	assert.NoError(t, err)
+	list := func(jiraType string) func() ([]importCandidate, error) {
+		return func() ([]importCandidate, error) { return alertChannelImportCandidates(channels, jiraType), nil }
+	}
+	jira := []string{api.JiraAlertChannelType.String()}
+
+	guid, err := resolveImportID("name:jira", "alert channel", jira, list(api.JiraCloudAlertType))
+	assert.NoError(t, err)
+	assert.Equal(t, "GUID_1", guid)
+
+	guid, err = resolveImportID("name:jira", "alert channel", jira, list(api.JiraServerAlertType))
+	assert.NoError(t, err)
+	assert.Equal(t, "GUID_2", guid)
+
+	assert.Len(t, alertChannelImportCandidates(channels, ""), 3)
+}
@@ -0,0 +1,182 @@
+package lacework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/lacework/go-sdk/v2/lwtime"
+)
+
+// integrationStateSchema is the schema of the computed state of the cloud account
+// and container registry integrations, as reported by the Lacework platform
+func integrationStateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The state of the integration as reported by the Lacework platform",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ok": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the integration is healthy",
+				},
+				"last_updated_time": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The last time the state of the integration was updated",
+				},
+				"last_successful_time": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The last time the integration was healthy",
+				},
+				"details": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The JSON encoded details of the state, like the error of an unhealthy integration",
+				},
+			},
+		},
+	}
+}
+
+// integrationWaitForHealthySchema is the schema of the wait_for_healthy attribute of the
+// cloud account and container registry integrations
+func integrationWaitForHealthySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "The maximum time to wait for the integration to be healthy after it is created " +
+			"or its configuration is updated (i.e. 5m), the apply fails when the integration is not " +
+			"healthy in time",
+		ValidateDiagFunc: validation.ToDiagFunc(func(value interface{}, key string) ([]string, []error) {
+			if _, err := time.ParseDuration(value.(string)); err != nil {
+				return nil, []error{fmt.Errorf("%s: invalid duration '%s'", key, value)}
+			}
+			return nil, nil
+		}),
+	}
+}
+
+func flattenIntegrationState(state *api.V2IntegrationState) []map[string]interface{} {
+	if state == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"ok":                   state.Ok,
+		"last_updated_time":    formatIntegrationStateTime(state.LastUpdatedTime),
+		"last_successful_time": formatIntegrationStateTime(state.LastSuccessfulTime),
+		"details":              integrationStateDetails(state),
+	}}
+}
+
+func formatIntegrationStateTime(epoch lwtime.Epoch) string {
+	if epoch.ToTime().IsZero() || epoch.ToTime().Unix() == 0 {
+		return ""
+	}
+	return epoch.String()
+}
+
+// integrationStateDetails returns the JSON encoded details of the state of an integration
+func integrationStateDetails(state *api.V2IntegrationState) string {
+	if state == nil || len(state.Details) == 0 {
+		return ""
+	}
+
+	details, err := json.Marshal(state.Details)
+	if err != nil {
+		return fmt.Sprintf("%v", state.Details)
+	}
+	return string(details)
+}
+
+// cloudAccountWaitForHealthy wraps the create or update function of a cloud account
+// integration to wait for the integration to be healthy when wait_for_healthy is set
+func cloudAccountWaitForHealthy(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		lacework := meta.(*providerMeta).client
+		return waitForIntegrationHealthy(d, func() (*api.V2IntegrationState, error) {
+			var response api.CloudAccountResponse
+			err := lacework.V2.CloudAccounts.Get(d.Id(), &response)
+			return response.Data.State, err
+		})
+	}
+}
+
+// containerRegistryWaitForHealthy wraps the create or update function of a container
+// registry integration to wait for the integration to be healthy when wait_for_healthy is set
+func containerRegistryWaitForHealthy(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		lacework := meta.(*providerMeta).client
+		return waitForIntegrationHealthy(d, func() (*api.V2IntegrationState, error) {
+			var response api.ContainerRegistryResponse
+			err := lacework.V2.ContainerRegistries.Get(d.Id(), &response)
+			return response.Data.State, err
+		})
+	}
+}
+
+// integrationNonConfigAttributes are the attributes that don't change the credentials or the
+// configuration of an integration, updating only these attributes doesn't wait for it to be healthy
+var integrationNonConfigAttributes = []string{"name", "wait_for_healthy", "retries", "state"}
+
+// waitForIntegrationHealthy polls the state of an integration until it is healthy,
+// or fails with the details of the state once the wait_for_healthy timeout expires
+func waitForIntegrationHealthy(d *schema.ResourceData, getState func() (*api.V2IntegrationState, error)) error {
+	waitFor, ok := d.GetOk("wait_for_healthy")
+	if !ok || d.Id() == "" {
+		return nil
+	}
+	if !d.IsNewResource() && !d.HasChangesExcept(integrationNonConfigAttributes...) {
+		log.Printf("[INFO] Integration with guid %s was updated without configuration changes, "+
+			"not waiting for it to be healthy\n", d.Id())
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(waitFor.(string))
+	if err != nil {
+		return fmt.Errorf("invalid wait_for_healthy duration '%s': %s", waitFor, err)
+	}
+
+	log.Printf("[INFO] Waiting up to %s for integration with guid %s to be healthy\n", timeout, d.Id())
+	return retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		state, err := getState()
+		if err != nil {
+			return retry.NonRetryableError(
+				fmt.Errorf("unable to read the state of integration with guid %s: %s", d.Id(), err),
+			)
+		}
+		d.Set("state", flattenIntegrationState(state))
+
+		if state == nil || !state.Ok {
+			details := integrationStateDetails(state)
+			if details == "" {
+				details = "no details reported"
+			}
+			return retry.RetryableError(fmt.Errorf(
+				"integration with guid %s is not healthy after %s: %s", d.Id(), timeout, details,
+			))
+		}
+
+		log.Printf("[INFO] Integration with guid %s is healthy\n", d.Id())
+		return nil
+	})
+}
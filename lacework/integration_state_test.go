@@ -0,0 +1,140 @@
+package lacework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/lacework/go-sdk/v2/lwtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenIntegrationState(t *testing.T) {
+	assert.Nil(t, flattenIntegrationState(nil))
+
+	lastSuccess := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	state := flattenIntegrationState(&api.V2IntegrationState{
+		Ok:                 false,
+		Details:            map[string]interface{}{"errorMap": map[string]interface{}{"AccessDenied": "not authorized"}},
+		LastUpdatedTime:    lwtime.Epoch(lastSuccess.Add(time.Hour)),
+		LastSuccessfulTime: lwtime.Epoch(lastSuccess),
+	})
+
+	assert.Equal(t, []map[string]interface{}{{
+		"ok":                   false,
+		"last_updated_time":    "2026-01-02T04:04:05Z",
+		"last_successful_time": "2026-01-02T03:04:05Z",
+		"details":              `{"errorMap":{"AccessDenied":"not authorized"}}`,
+	}}, state)
+}
+
+func TestFlattenIntegrationStateNeverSuccessful(t *testing.T) {
+	state := flattenIntegrationState(&api.V2IntegrationState{
+		Ok:                 false,
+		LastSuccessfulTime: lwtime.Epoch(time.Unix(0, 0)),
+	})
+
+	assert.Equal(t, "", state[0]["last_successful_time"])
+	assert.Equal(t, "", state[0]["last_updated_time"])
+	assert.Equal(t, "", state[0]["details"])
+}
+
+func TestWaitForIntegrationHealthy(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{
+		"state":            integrationStateSchema(),
+		"wait_for_healthy": integrationWaitForHealthySchema(),
+	}}
+
+	t.Run("not configured", func(t *testing.T) {
+		d := resource.TestResourceData()
+		d.SetId("INTG_GUID")
+		err := waitForIntegrationHealthy(d, func() (*api.V2IntegrationState, error) {
+			t.Fatal("the state should not be read")
+			return nil, nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("healthy", func(t *testing.T) {
+		d := resource.TestResourceData()
+		d.MarkNewResource()
+		d.SetId("INTG_GUID")
+		d.Set("wait_for_healthy", "1m")
+		err := waitForIntegrationHealthy(d, func() (*api.V2IntegrationState, error) {
+			return &api.V2IntegrationState{Ok: true}, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, true, d.Get("state.0.ok"))
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		d := resource.TestResourceData()
+		d.MarkNewResource()
+		d.SetId("INTG_GUID")
+		d.Set("wait_for_healthy", "1s")
+		err := waitForIntegrationHealthy(d, func() (*api.V2IntegrationState, error) {
+			return &api.V2IntegrationState{
+				Details: map[string]interface{}{"message": "unable to assume role"},
+			}, nil
+		})
+		assert.ErrorContains(t, err, "integration with guid INTG_GUID is not healthy after 1s")
+		assert.ErrorContains(t, err, `{"message":"unable to assume role"}`)
+		assert.Equal(t, false, d.Get("state.0.ok"))
+	})
+}
+
+func TestWaitForIntegrationHealthyOnUpdate(t *testing.T) {
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{
+		"name":             {Type: schema.TypeString, Required: true},
+		"role_arn":         {Type: schema.TypeString, Required: true},
+		"state":            integrationStateSchema(),
+		"wait_for_healthy": integrationWaitForHealthySchema(),
+	}}
+	state := &terraform.InstanceState{
+		ID: "INTG_GUID",
+		Attributes: map[string]string{
+			"id":               "INTG_GUID",
+			"name":             "integration",
+			"role_arn":         "arn:aws:iam::123456789012:role/old",
+			"wait_for_healthy": "1m",
+		},
+	}
+	update := func(t *testing.T, cfg map[string]interface{}) *schema.ResourceData {
+		diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(cfg), nil)
+		assert.NoError(t, err)
+		d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+		assert.NoError(t, err)
+		return d
+	}
+
+	t.Run("name changed", func(t *testing.T) {
+		d := update(t, map[string]interface{}{
+			"name":             "renamed",
+			"role_arn":         "arn:aws:iam::123456789012:role/old",
+			"wait_for_healthy": "1m",
+		})
+		err := waitForIntegrationHealthy(d, func() (*api.V2IntegrationState, error) {
+			t.Fatal("the state should not be read")
+			return nil, nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("credentials changed", func(t *testing.T) {
+		d := update(t, map[string]interface{}{
+			"name":             "integration",
+			"role_arn":         "arn:aws:iam::123456789012:role/new",
+			"wait_for_healthy": "1m",
+		})
+		read := false
+		err := waitForIntegrationHealthy(d, func() (*api.V2IntegrationState, error) {
+			read = true
+			return &api.V2IntegrationState{Ok: true}, nil
+		})
+		assert.NoError(t, err)
+		assert.True(t, read)
+	})
+}
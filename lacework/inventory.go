@@ -0,0 +1,131 @@
+package lacework
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// inventoryClouds are the cloud providers supported by the inventory endpoints
+var inventoryClouds = []string{"AWS", "Azure", "GCP"}
+
+// inventoryAccountDetails are the cloud details that identify the cloud account of the inventory resources
+var inventoryAccountDetails = map[string]string{
+	"AWS":   "accountID",
+	"Azure": "subscriptionId",
+	"GCP":   "projectId",
+}
+
+// newInventorySearch returns the inventory search of the provided cloud over a time window
+func newInventorySearch(cloud string, start, end time.Time) (api.InventorySearch, error) {
+	search := api.InventorySearch{
+		SearchFilter: api.SearchFilter{
+			TimeFilter: &api.TimeFilter{StartTime: &start, EndTime: &end},
+		},
+	}
+
+	switch cloud {
+	case "AWS":
+		search.Csp = api.AwsInventoryType
+		search.Dataset = api.AwsInventoryDataset
+	case "Azure":
+		search.Csp = api.AzureInventoryType
+		search.Dataset = "AzureCompliance"
+	case "GCP":
+		search.Csp = api.GcpInventoryType
+		search.Dataset = "GcpCompliance"
+	default:
+		return search, fmt.Errorf("unsupported cloud %s, must be one of %v", cloud, inventoryClouds)
+	}
+	return search, nil
+}
+
+// inventoryResource is a resource of the inventory of any cloud, the SDK only provides
+// the type of the AWS resources
+type inventoryResource struct {
+	Csp            string                 `json:"csp"`
+	StartTime      string                 `json:"startTime"`
+	EndTime        string                 `json:"endTime"`
+	ResourceId     string                 `json:"resourceId"`
+	ResourceRegion string                 `json:"resourceRegion"`
+	ResourceTags   interface{}            `json:"resourceTags"`
+	ResourceType   string                 `json:"resourceType"`
+	Service        string                 `json:"service"`
+	Urn            string                 `json:"urn"`
+	CloudDetails   map[string]interface{} `json:"cloudDetails"`
+	ResourceConfig interface{}            `json:"resourceConfig"`
+}
+
+// inventorySearchResponse is a page of inventory resources, it implements api.Pageable
+// to read all the pages with the client
+type inventorySearchResponse struct {
+	Data   []inventoryResource `json:"data"`
+	Paging api.V2Pagination    `json:"paging"`
+
+	totalPages int
+	pageNumber int
+}
+
+func (r *inventorySearchResponse) PageInfo() *api.V2Pagination { return &r.Paging }
+func (r *inventorySearchResponse) ResetPaging() {
+	r.Paging = api.V2Pagination{}
+	r.Data = nil
+}
+func (r *inventorySearchResponse) PageRead()               { r.pageNumber++ }
+func (r *inventorySearchResponse) SetTotalPages(total int) { r.totalPages = total }
+func (r *inventorySearchResponse) TotalPages() int         { return r.totalPages }
+func (r *inventorySearchResponse) PageNumber() int         { return r.pageNumber }
+
+// searchInventory returns the inventory resources of all the pages matching the search
+func searchInventory(lacework *api.Client, search api.InventorySearch) ([]inventoryResource, error) {
+	var (
+		response  inventorySearchResponse
+		resources []inventoryResource
+	)
+	if err := lacework.V2.Inventory.Search(&response, search); err != nil {
+		return nil, fmt.Errorf("unable to search the %s inventory: %s", search.Csp, err)
+	}
+
+	for {
+		resources = append(resources, response.Data...)
+
+		pageOk, err := lacework.NextPage(&response)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the next page of the %s inventory: %s", search.Csp, err)
+		}
+		if !pageOk {
+			return resources, nil
+		}
+	}
+}
+
+// inventoryAccountID returns the id of the cloud account of an inventory resource
+func inventoryAccountID(cloud string, resource inventoryResource) string {
+	if id, ok := resource.CloudDetails[inventoryAccountDetails[cloud]].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// inventoryTags returns the tags of an inventory resource, the values that are not
+// strings are JSON encoded
+func inventoryTags(resource inventoryResource) map[string]string {
+	tags := map[string]string{}
+	raw, ok := resource.ResourceTags.(map[string]interface{})
+	if !ok {
+		return tags
+	}
+	for key, value := range raw {
+		if str, ok := value.(string); ok {
+			tags[key] = str
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err == nil {
+			tags[key] = string(encoded)
+		}
+	}
+	return tags
+}
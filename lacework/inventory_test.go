@@ -0,0 +1,68 @@
+package lacework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ api.Pageable = &inventorySearchResponse{}
+
+func TestExpandInventorySearch(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceLaceworkInventoryResources().Schema, map[string]interface{}{
+		"cloud":          "GCP",
+		"resource_types": []interface{}{"storage.googleapis.com/Bucket"},
+		"accounts":       []interface{}{"project-b", "project-a"},
+		"tags":           map[string]interface{}{"team": "platform", "env": "production"},
+	})
+
+	search, err := expandInventorySearch(d)
+	if assert.NoError(t, err) {
+		assert.Equal(t, api.GcpInventoryType, search.Csp)
+		assert.EqualValues(t, "GcpCompliance", search.Dataset)
+		assert.NotNil(t, search.TimeFilter.StartTime)
+		assert.Equal(t, []api.Filter{
+			{Field: "resourceType", Expression: "in", Values: []string{"storage.googleapis.com/Bucket"}},
+			{Field: "cloudDetails.projectId", Expression: "in", Values: []string{"project-a", "project-b"}},
+			{Field: "resourceTags.env", Expression: "eq", Value: "production"},
+			{Field: "resourceTags.team", Expression: "eq", Value: "platform"},
+		}, search.Filters)
+	}
+}
+
+func TestNewInventorySearchUnsupportedCloud(t *testing.T) {
+	now := time.Now()
+	_, err := newInventorySearch("OCI", now, now)
+	assert.Error(t, err)
+}
+
+func TestFlattenInventoryResources(t *testing.T) {
+	resources := []inventoryResource{
+		{
+			Urn:            "arn:aws:s3:::b",
+			ResourceId:     "b",
+			ResourceType:   "s3:bucket",
+			ResourceRegion: "us-west-2",
+			ResourceTags:   map[string]interface{}{"env": "production", "count": 2.0},
+			CloudDetails:   map[string]interface{}{"accountID": "123456789012"},
+			ResourceConfig: map[string]interface{}{"Name": "b"},
+		},
+		{Urn: "arn:aws:s3:::a"},
+		{Urn: "arn:aws:s3:::b"},
+	}
+
+	flattened, urns, err := flattenInventoryResources("AWS", resources)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"arn:aws:s3:::a", "arn:aws:s3:::b"}, urns)
+		if assert.Len(t, flattened, 3) {
+			assert.Equal(t, "123456789012", flattened[0]["account"])
+			assert.Equal(t, map[string]string{"env": "production", "count": "2"}, flattened[0]["tags"])
+			assert.Equal(t, `{"Name":"b"}`, flattened[0]["resource_config"])
+			assert.Equal(t, "", flattened[1]["account"])
+			assert.Equal(t, map[string]string{}, flattened[1]["tags"])
+		}
+	}
+}
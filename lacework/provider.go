@@ -67,6 +67,7 @@ func Provider() *schema.Provider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"lacework_agent_access_token":                     resourceLaceworkAgentAccessToken(),
+			"lacework_alert_action":                           resourceLaceworkAlertAction(),
 			"lacework_alert_channel_aws_cloudwatch":           resourceLaceworkAlertChannelAwsCloudWatch(),
 			"lacework_alert_channel_aws_s3":                   resourceLaceworkAlertChannelAwsS3(),
 			"lacework_alert_channel_cisco_webex":              resourceLaceworkAlertChannelCiscoWebex(),
@@ -98,9 +99,11 @@ func Provider() *schema.Provider {
 			"lacework_integration_azure_cfg":                  resourceLaceworkIntegrationAzureCfg(),
 			"lacework_integration_azure_ad_al":                resourceLaceworkIntegrationAzureAdAl(),
 			"lacework_integration_azure_al":                   resourceLaceworkIntegrationAzureActivityLog(),
+			"lacework_integration_cloud_account":              resourceLaceworkIntegrationCloudAccount(),
 			"lacework_integration_docker_hub":                 resourceLaceworkIntegrationDockerHub(),
 			"lacework_integration_docker_v2":                  resourceLaceworkIntegrationDockerV2(),
 			"lacework_integration_ecr":                        resourceLaceworkIntegrationEcr(),
+			"lacework_integration_gcp_at":                     resourceLaceworkIntegrationGcpAt(),
 			"lacework_integration_gcp_cfg":                    resourceLaceworkIntegrationGcpCfg(),
 			"lacework_integration_gcp_pub_sub_audit_log":      resourceLaceworkIntegrationGcpPubSubAuditLog(),
 			"lacework_integration_gcp_gke_audit_log":          resourceLaceworkIntegrationGcpGkeAuditLog(),
@@ -116,21 +119,39 @@ func Provider() *schema.Provider {
 			"lacework_policy":                                 resourceLaceworkPolicy(),
 			"lacework_policy_compliance":                      resourceLaceworkPolicyCompliance(),
 			"lacework_policy_exception":                       resourceLaceworkPolicyException(),
+			"lacework_report_definition":                      resourceLaceworkReportDefinition(),
+			"lacework_report_distribution":                    resourceLaceworkReportDistribution(),
 			"lacework_report_rule":                            resourceLaceworkReportRule(),
 			"lacework_resource_group":                         resourceLaceworkResourceGroup(),
 			"lacework_integration_azure_agentless_scanning":   resourceLaceworkIntegrationAzureAgentlessScanning(),
 			"lacework_team_member":                            resourceLaceworkTeamMember(),
 			"lacework_vulnerability_exception_container":      resourceLaceworkVulnerabilityExceptionContainer(),
 			"lacework_vulnerability_exception_host":           resourceLaceworkVulnerabilityExceptionHost(),
+			"lacework_container_image_assessment":             resourceLaceworkContainerImageAssessment(),
+			"lacework_inventory_scan":                         resourceLaceworkInventoryScan(),
 			"lacework_integration_aws_dspm":                   resourceLaceworkAwsDspm(),
 			"lacework_integration_azure_dspm":                 resourceLaceworkAzureDspm(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"lacework_api_token":          dataSourceLaceworkApiToken(),
-			"lacework_agent_access_token": dataSourceLaceworkAgentAccessToken(),
-			"lacework_metric_module":      dataSourceLaceworkMetricModule(),
-			"lacework_user_profile":       dataSourceLaceworkUserProfile(),
+			"lacework_api_token":                     dataSourceLaceworkApiToken(),
+			"lacework_agent_access_token":            dataSourceLaceworkAgentAccessToken(),
+			"lacework_alerts":                        dataSourceLaceworkAlerts(),
+			"lacework_compliance_frameworks":         dataSourceLaceworkComplianceFrameworks(),
+			"lacework_container_images":              dataSourceLaceworkContainerImages(),
+			"lacework_container_vulnerabilities":     dataSourceLaceworkContainerVulnerabilities(),
+			"lacework_containers":                    dataSourceLaceworkContainers(),
+			"lacework_host_vulnerabilities":          dataSourceLaceworkHostVulnerabilities(),
+			"lacework_image_vulnerability_summaries": dataSourceLaceworkImageVulnerabilitySummaries(),
+			"lacework_inventory_resources":           dataSourceLaceworkInventoryResources(),
+			"lacework_lql_datasource":                dataSourceLaceworkLqlDatasource(),
+			"lacework_lql_datasources":               dataSourceLaceworkLqlDatasources(),
+			"lacework_machine_users":                 dataSourceLaceworkMachineUsers(),
+			"lacework_machines":                      dataSourceLaceworkMachines(),
+			"lacework_metric_module":                 dataSourceLaceworkMetricModule(),
+			"lacework_policies":                      dataSourceLaceworkPolicies(),
+			"lacework_query_result":                  dataSourceLaceworkQueryResult(),
+			"lacework_user_profile":                  dataSourceLaceworkUserProfile(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -200,15 +221,16 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 			apiOpts = append(apiOpts, api.WithOrgAccess())
 		}
 
-		lw, err := api.NewClient(account, apiOpts...)
+		meta, err := newProviderMeta(account, apiOpts...)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Unable to create Lacework API client",
 				Detail:   err.Error(),
 			})
+			return nil, diags
 		}
-		return lw, diags
+		return meta, diags
 	}
 
 	// authentication via configuration file
@@ -315,15 +337,39 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		apiOpts = append(apiOpts, api.WithOrgAccess())
 	}
 
-	lw, err := api.NewClient(account, apiOpts...)
+	meta, err := newProviderMeta(account, apiOpts...)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to create Lacework API client",
 			Detail:   err.Error(),
 		})
+		return nil, diags
 	}
-	return lw, diags
+	return meta, diags
+}
+
+// providerMeta is the meta of the Lacework provider, the API client of the provider along with
+// the account and the options it was created with, to create separate clients with the same
+// credentials without changing the provider client, i.e. to generate tokens with another expiration
+type providerMeta struct {
+	client  *api.Client
+	account string
+	opts    []api.Option
+}
+
+func newProviderMeta(account string, opts ...api.Option) (*providerMeta, error) {
+	lw, err := api.NewClient(account, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &providerMeta{client: lw, account: account, opts: opts}, nil
+}
+
+// newClient creates a new API client with the account and the options of the provider client,
+// followed by the provided options
+func (m *providerMeta) newClient(opts ...api.Option) (*api.Client, error) {
+	return api.NewClient(m.account, append(append([]api.Option{}, m.opts...), opts...)...)
 }
 
 func verifyPrimaryAccount(account string, opts ...api.Option) (string, error) {
@@ -0,0 +1,94 @@
+package lacework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// FrameworkProvider returns the Lacework provider of the types only supported by the
+// terraform-plugin-framework, like ephemeral resources, it is served along with the
+// schema.Provider and shares its configuration
+func FrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+type frameworkProvider struct{}
+
+var _ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "lacework"
+	resp.Version = version
+}
+
+// Schema is the schema of the schema.Provider, both providers must have the same schema
+// to be served together
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := map[string]providerschema.Attribute{}
+	for name, s := range Provider().Schema {
+		switch s.Type {
+		case schema.TypeBool:
+			attributes[name] = providerschema.BoolAttribute{Optional: s.Optional, Description: s.Description}
+		default:
+			attributes[name] = providerschema.StringAttribute{Optional: s.Optional, Description: s.Description}
+		}
+	}
+	resp.Schema = providerschema.Schema{Attributes: attributes}
+}
+
+// Configure configures the schema.Provider with the same configuration to share its meta, the
+// diagnostics are not reported since the schema.Provider reports them when it is configured
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var values map[string]tftypes.Value
+	if err := req.Config.Raw.As(&values); err != nil {
+		return
+	}
+
+	config := map[string]interface{}{}
+	for name, value := range values {
+		if !value.IsKnown() {
+			return
+		}
+		if value.IsNull() {
+			continue
+		}
+
+		var (
+			str     string
+			boolean bool
+		)
+		if value.As(&str) == nil {
+			config[name] = str
+		} else if value.As(&boolean) == nil {
+			config[name] = boolean
+		}
+	}
+
+	sdkProvider := Provider()
+	if diags := sdkProvider.Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		return
+	}
+	resp.EphemeralResourceData = sdkProvider.Meta()
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralLaceworkApiToken,
+	}
+}
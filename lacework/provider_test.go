@@ -0,0 +1,40 @@
+package lacework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderMetaNewClient(t *testing.T) {
+	lacework, err := newProviderMeta("demo", api.WithApiKeys("KEY", "SECRET"), api.WithSubaccount("sub"))
+	assert.NoError(t, err)
+
+	client, err := lacework.newClient(api.WithExpirationTime(600))
+	assert.NoError(t, err)
+	assert.NotSame(t, lacework.client, client)
+	assert.Equal(t, lacework.client.URL(), client.URL())
+	assert.Len(t, lacework.opts, 2, "the options of the provider client must not change")
+}
+
+func TestFrameworkProviderMux(t *testing.T) {
+	ctx := context.Background()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		Provider().GRPCProvider,
+		providerserver.NewProtocol5(FrameworkProvider()),
+	)
+	require.NoError(t, err)
+
+	// the providers can only be served together when their provider schemas are the same
+	response, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, response.Diagnostics)
+	assert.Contains(t, response.EphemeralResourceSchemas, "lacework_api_token")
+	assert.Contains(t, response.DataSourceSchemas, "lacework_api_token")
+}
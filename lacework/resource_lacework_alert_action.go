@@ -0,0 +1,269 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func resourceLaceworkAlertAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLaceworkAlertActionCreate,
+		Read:   resourceLaceworkAlertActionRead,
+		Update: resourceLaceworkAlertActionUpdate,
+		Delete: resourceLaceworkAlertActionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importLaceworkAlertAction,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alert_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The id of the alert",
+			},
+			"close": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Close the alert with the provided reason",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reason": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: fmt.Sprintf("The reason to close the alert. Valid reasons are: %s",
+								strings.Join(api.AlertCloseReasons.GetOrderedReasonStrings(), ", ")),
+							ValidateFunc: validation.StringInSlice(
+								api.AlertCloseReasons.GetOrderedReasonStrings(), false),
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The comment to add to the alert when it is closed",
+						},
+					},
+				},
+			},
+			"comments": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The comments to add to the alert, comments are added only once",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the alert",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the alert",
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The severity of the alert",
+			},
+		},
+	}
+}
+
+func resourceLaceworkAlertActionCreate(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+	alertID := d.Get("alert_id").(int)
+
+	exists, err := lacework.V2.Alerts.Exists(alertID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("alert with id %d was not found", alertID)
+	}
+
+	d.SetId(strconv.Itoa(alertID))
+
+	if err := addAlertComments(lacework, alertID, nil, castStringSlice(d.Get("comments").([]interface{}))); err != nil {
+		return err
+	}
+
+	if err := closeAlert(d, lacework, alertID); err != nil {
+		return err
+	}
+
+	return resourceLaceworkAlertActionRead(d, meta)
+}
+
+func resourceLaceworkAlertActionRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	alertID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid alert id '%s'", d.Id())
+	}
+
+	log.Printf("[INFO] Reading Alert with id %d\n", alertID)
+	response, err := lacework.V2.Alerts.GetDetails(alertID)
+	if err != nil {
+		return resourceNotFound(d, err)
+	}
+
+	d.Set("alert_id", alertID)
+	d.Set("status", response.Data.Status)
+	d.Set("name", response.Data.Name)
+	d.Set("severity", response.Data.Severity)
+
+	// an alert that was reopened outside of Terraform no longer matches the
+	// close action, removing it from the state makes the next apply close it
+	if response.Data.Status != "Closed" {
+		d.Set("close", nil)
+	}
+
+	log.Printf("[INFO] Read Alert with id %d and status %s\n", alertID, response.Data.Status)
+	return nil
+}
+
+func resourceLaceworkAlertActionUpdate(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+	alertID := d.Get("alert_id").(int)
+
+	if d.HasChange("comments") {
+		old, new := d.GetChange("comments")
+		err := addAlertComments(lacework, alertID,
+			castStringSlice(old.([]interface{})), castStringSlice(new.([]interface{})))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("close") {
+		if err := closeAlert(d, lacework, alertID); err != nil {
+			return err
+		}
+	}
+
+	return resourceLaceworkAlertActionRead(d, meta)
+}
+
+func resourceLaceworkAlertActionDelete(d *schema.ResourceData, _ interface{}) error {
+	// alerts can't be reopened and comments can't be deleted, the actions
+	// taken on the alert are kept and the resource is removed from the state
+	log.Printf("[INFO] Removing actions on Alert with id %s from the state\n", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func importLaceworkAlertAction(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Importing Lacework Alert with id: %s\n", d.Id())
+
+	alertID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert id '%s', it must be a number", d.Id())
+	}
+
+	exists, err := lacework.V2.Alerts.Exists(alertID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf(
+			"unable to import Lacework resource. Alert with id '%d' was not found", alertID,
+		)
+	}
+
+	// the comments already posted to the alert are seeded in the state, so that
+	// the configured comments that match them are not posted again
+	timeline, err := lacework.V2.Alerts.GetTimeline(alertID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the comments of Alert with id %d: %s", alertID, err)
+	}
+
+	d.Set("alert_id", alertID)
+	d.Set("comments", alertTimelineComments(timeline.Data))
+	log.Printf("[INFO] Alert found with id: %d\n", alertID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// closeAlert closes the alert when the close block is configured and the alert is open
+func closeAlert(d *schema.ResourceData, lacework *api.Client, alertID int) error {
+	if len(d.Get("close").([]interface{})) == 0 {
+		return nil
+	}
+
+	response, err := lacework.V2.Alerts.GetDetails(alertID)
+	if err != nil {
+		return fmt.Errorf("unable to read the status of Alert with id %d: %s", alertID, err)
+	}
+	if response.Data.Status == "Closed" {
+		log.Printf("[INFO] Alert with id %d is already closed\n", alertID)
+		return nil
+	}
+
+	reason, ok := findAlertCloseReason(d.Get("close.0.reason").(string))
+	if !ok {
+		return fmt.Errorf("invalid close reason '%s'", d.Get("close.0.reason").(string))
+	}
+
+	request := api.AlertCloseRequest{
+		AlertID: alertID,
+		Reason:  reason,
+		Comment: d.Get("close.0.comment").(string),
+	}
+
+	log.Printf("[INFO] Closing Alert with data:\n%+v\n", request)
+	if _, err := lacework.V2.Alerts.Close(request); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Closed Alert with id %d\n", alertID)
+	return nil
+}
+
+// addAlertComments adds the comments that were not previously added to the alert
+func addAlertComments(lacework *api.Client, alertID int, old, new []string) error {
+	for _, comment := range new {
+		if ContainsStr(old, comment) {
+			continue
+		}
+
+		log.Printf("[INFO] Adding comment to Alert with id %d\n", alertID)
+		if _, err := lacework.V2.Alerts.Comment(alertID, comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// alertTimelineComments returns the comments posted to an alert, in the order of its timeline
+func alertTimelineComments(timeline []api.AlertTimeline) []string {
+	comments := []string{}
+	for _, entry := range timeline {
+		if !strings.Contains(strings.ToLower(entry.EntryType), "comment") || entry.Message.Value == "" {
+			continue
+		}
+		comments = append(comments, entry.Message.Value)
+	}
+	return comments
+}
+
+func findAlertCloseReason(reason string) (int, bool) {
+	for i, r := range api.AlertCloseReasons {
+		if r == reason {
+			return int(i), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,20 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertTimelineComments(t *testing.T) {
+	assert.Equal(t, []string{}, alertTimelineComments(nil))
+
+	comments := alertTimelineComments([]api.AlertTimeline{
+		{EntryType: "UserComment", Message: api.AlertTimelineMessage{Value: "Triaged as a known false positive"}},
+		{EntryType: "StatusChange", Message: api.AlertTimelineMessage{Value: "Status changed to Closed"}},
+		{EntryType: "UserComment", Message: api.AlertTimelineMessage{Value: ""}},
+		{EntryType: "UserComment", Message: api.AlertTimelineMessage{Value: "Tracked in SEC-123"}},
+	})
+	assert.Equal(t, []string{"Triaged as a known false positive", "Tracked in SEC-123"}, comments)
+}
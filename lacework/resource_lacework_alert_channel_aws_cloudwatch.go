@@ -17,7 +17,7 @@ func resourceLaceworkAlertChannelAwsCloudWatch() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelAwsCloudWatchDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.CloudwatchEbAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -88,7 +88,7 @@ func resourceLaceworkAlertChannelAwsCloudWatch() *schema.Resource {
 
 func resourceLaceworkAlertChannelAwsCloudWatchCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		alert    = api.NewAlertChannel(d.Get("name").(string),
 			api.CloudwatchEbAlertChannelType,
 			api.CloudwatchEbDataV2{
@@ -130,7 +130,7 @@ func resourceLaceworkAlertChannelAwsCloudWatchCreate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkAlertChannelAwsCloudWatchRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.CloudwatchEbAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetCloudwatchEb(d.Id())
@@ -156,7 +156,7 @@ func resourceLaceworkAlertChannelAwsCloudWatchRead(d *schema.ResourceData, meta
 
 func resourceLaceworkAlertChannelAwsCloudWatchUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		alert    = api.NewAlertChannel(d.Get("name").(string),
 			api.CloudwatchEbAlertChannelType,
 			api.CloudwatchEbDataV2{
@@ -200,7 +200,7 @@ func resourceLaceworkAlertChannelAwsCloudWatchUpdate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkAlertChannelAwsCloudWatchDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.CloudwatchEbAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -16,7 +16,7 @@ func resourceLaceworkAlertChannelAwsS3() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelAwsS3Delete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.AwsS3AlertChannelType.String()),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -87,7 +87,7 @@ func resourceLaceworkAlertChannelAwsS3() *schema.Resource {
 
 func resourceLaceworkAlertChannelAwsS3Create(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		s3       = api.NewAlertChannel(d.Get("name").(string),
 			api.AwsS3AlertChannelType,
 			api.AwsS3DataV2{
@@ -132,7 +132,7 @@ func resourceLaceworkAlertChannelAwsS3Create(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkAlertChannelAwsS3Read(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.AwsS3AlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetAwsS3(d.Id())
@@ -162,7 +162,7 @@ func resourceLaceworkAlertChannelAwsS3Read(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkAlertChannelAwsS3Update(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		s3       = api.NewAlertChannel(d.Get("name").(string),
 			api.AwsS3AlertChannelType,
 			api.AwsS3DataV2{
@@ -209,7 +209,7 @@ func resourceLaceworkAlertChannelAwsS3Update(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkAlertChannelAwsS3Delete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.AwsS3AlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
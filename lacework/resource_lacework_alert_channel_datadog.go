@@ -16,7 +16,7 @@ func resourceLaceworkAlertChannelDatadog() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelDatadogDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.DatadogAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -73,8 +73,10 @@ func resourceLaceworkAlertChannelDatadog() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
 				Description: "The Datadog api key required to submit metrics and events to Datadog",
 			},
+			"api_key_version": secretVersionSchema("api_key"),
 			"test_integration": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -110,13 +112,13 @@ func resourceLaceworkAlertChannelDatadogCreate(d *schema.ResourceData, meta inte
 	service, _ := api.DatadogService(d.Get("datadog_service").(string))
 
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		datadog  = api.NewAlertChannel(d.Get("name").(string),
 			api.DatadogAlertChannelType,
 			api.DatadogDataV2{
 				DatadogSite: site,
 				DatadogType: service,
-				ApiKey:      d.Get("api_key").(string),
+				ApiKey:      getWriteOnlyString(d, "api_key"),
 			},
 		)
 	)
@@ -154,7 +156,7 @@ func resourceLaceworkAlertChannelDatadogCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelDatadogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.DatadogAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetDatadog(d.Id())
@@ -182,13 +184,13 @@ func resourceLaceworkAlertChannelDatadogUpdate(d *schema.ResourceData, meta inte
 	service, _ := api.DatadogService(d.Get("datadog_service").(string))
 
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		datadog  = api.NewAlertChannel(d.Get("name").(string),
 			api.DatadogAlertChannelType,
 			api.DatadogDataV2{
 				DatadogSite: site,
 				DatadogType: service,
-				ApiKey:      d.Get("api_key").(string),
+				ApiKey:      getWriteOnlyString(d, "api_key"),
 			},
 		)
 	)
@@ -227,7 +229,7 @@ func resourceLaceworkAlertChannelDatadogUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelDatadogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.DatadogAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
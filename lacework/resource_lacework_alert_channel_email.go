@@ -17,7 +17,7 @@ func resourceLaceworkAlertChannelEmail() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelEmailDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.EmailUserAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,7 +76,7 @@ func resourceLaceworkAlertChannelEmail() *schema.Resource {
 
 func resourceLaceworkAlertChannelEmailCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework       = meta.(*api.Client)
+		lacework       = meta.(*providerMeta).client
 		emailAlertChan = api.NewAlertChannel(d.Get("name").(string),
 			api.EmailUserAlertChannelType,
 			api.EmailUserData{
@@ -118,7 +118,7 @@ func resourceLaceworkAlertChannelEmailCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkAlertChannelEmailRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %s\n", api.EmailUserAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetEmailUser(d.Id())
@@ -143,7 +143,7 @@ func resourceLaceworkAlertChannelEmailRead(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkAlertChannelEmailUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework       = meta.(*api.Client)
+		lacework       = meta.(*providerMeta).client
 		emailAlertChan = api.NewAlertChannel(d.Get("name").(string),
 			api.EmailUserAlertChannelType,
 			api.EmailUserData{
@@ -187,7 +187,7 @@ func resourceLaceworkAlertChannelEmailUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkAlertChannelEmailDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %s\n", api.EmailUserAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -17,7 +17,7 @@ func resourceLaceworkAlertChannelGcpPubSub() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelGcpPubSubDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.GcpPubSubAlertChannelType.String()),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -79,18 +79,10 @@ func resourceLaceworkAlertChannelGcpPubSub() *schema.Resource {
 							Type:        schema.TypeString,
 							Required:    true,
 							Sensitive:   true,
+							WriteOnly:   true,
 							Description: "The service account private key ID",
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// @afiune we can't compare this element since our API, for security reasons,
-								// does NOT return the private key configured in the Lacework server. So if
-								// any other element changed from the credentials then we trigger a diff
-								return !d.HasChanges(
-									"name", "project_id", "topic_id", "enabled", "issue_grouping",
-									"credentials.0.client_id",
-									"credentials.0.client_email",
-								)
-							},
 						},
+						"private_key_version": secretVersionSchema("private_key"),
 						"private_key_id": {
 							Type:        schema.TypeString,
 							Sensitive:   true,
@@ -104,6 +96,7 @@ func resourceLaceworkAlertChannelGcpPubSub() *schema.Resource {
 									"name", "project_id", "topic_id", "enabled", "issue_grouping",
 									"credentials.0.client_id",
 									"credentials.0.client_email",
+									"credentials.0.private_key_version",
 								)
 							},
 						},
@@ -142,7 +135,7 @@ func resourceLaceworkAlertChannelGcpPubSub() *schema.Resource {
 
 func resourceLaceworkAlertChannelGcpPubSubCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework  = meta.(*api.Client)
+		lacework  = meta.(*providerMeta).client
 		gcpPubSub = api.NewAlertChannel(d.Get("name").(string),
 			api.GcpPubSubAlertChannelType,
 			api.GcpPubSubDataV2{
@@ -152,7 +145,7 @@ func resourceLaceworkAlertChannelGcpPubSubCreate(d *schema.ResourceData, meta in
 				Credentials: api.GcpPubSubCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
 					ClientEmail:  d.Get("credentials.0.client_email").(string),
-					PrivateKey:   d.Get("credentials.0.private_key").(string),
+					PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 					PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
 				},
 			},
@@ -191,7 +184,7 @@ func resourceLaceworkAlertChannelGcpPubSubCreate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelGcpPubSubRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.GcpPubSubAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetGcpPubSub(d.Id())
@@ -210,11 +203,12 @@ func resourceLaceworkAlertChannelGcpPubSubRead(d *schema.ResourceData, meta inte
 	d.Set("topic_id", response.Data.Data.TopicID)
 	d.Set("issue_grouping", response.Data.Data.IssueGrouping)
 
-	creds := make(map[string]string)
+	creds := make(map[string]interface{})
 	creds["client_id"] = response.Data.Data.Credentials.ClientID
 	creds["client_email"] = response.Data.Data.Credentials.ClientEmail
 
-	d.Set("credentials", []map[string]string{creds})
+	creds["private_key_version"] = d.Get("credentials.0.private_key_version")
+	d.Set("credentials", []map[string]interface{}{creds})
 
 	log.Printf("[INFO] Read %s integration with guid %s\n",
 		api.GcpPubSubAlertChannelType, response.Data.IntgGuid)
@@ -223,7 +217,7 @@ func resourceLaceworkAlertChannelGcpPubSubRead(d *schema.ResourceData, meta inte
 
 func resourceLaceworkAlertChannelGcpPubSubUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework  = meta.(*api.Client)
+		lacework  = meta.(*providerMeta).client
 		gcpPubSub = api.NewAlertChannel(d.Get("name").(string),
 			api.GcpPubSubAlertChannelType,
 			api.GcpPubSubDataV2{
@@ -233,7 +227,7 @@ func resourceLaceworkAlertChannelGcpPubSubUpdate(d *schema.ResourceData, meta in
 				Credentials: api.GcpPubSubCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
 					ClientEmail:  d.Get("credentials.0.client_email").(string),
-					PrivateKey:   d.Get("credentials.0.private_key").(string),
+					PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 					PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
 				},
 			},
@@ -274,7 +268,7 @@ func resourceLaceworkAlertChannelGcpPubSubUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelGcpPubSubDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.GcpPubSubAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
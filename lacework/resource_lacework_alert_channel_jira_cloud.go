@@ -19,7 +19,7 @@ func resourceLaceworkAlertChannelJiraCloud() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelJiraCloudDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkJiraAlertChannel(api.JiraCloudAlertType),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,8 +58,10 @@ func resourceLaceworkAlertChannelJiraCloud() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
 				Description: "The Jira API Token",
 			},
+			"api_token_version": secretVersionSchema("api_token"),
 			"custom_template_file": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -133,7 +135,7 @@ func resourceLaceworkAlertChannelJiraCloud() *schema.Resource {
 
 func resourceLaceworkAlertChannelJiraCloudCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		jiraData           = api.JiraDataV2{
 			JiraUrl:       d.Get("jira_url").(string),
@@ -142,7 +144,7 @@ func resourceLaceworkAlertChannelJiraCloudCreate(d *schema.ResourceData, meta in
 			IssueGrouping: d.Get("group_issues_by").(string),
 			ProjectID:     d.Get("project_key").(string),
 			Username:      d.Get("username").(string),
-			ApiToken:      d.Get("api_token").(string),
+			ApiToken:      getWriteOnlyString(d, "api_token"),
 			JiraType:      api.JiraCloudAlertType,
 		}
 	)
@@ -185,7 +187,7 @@ func resourceLaceworkAlertChannelJiraCloudCreate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelJiraCloudRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.JiraAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetJira(d.Id())
@@ -223,7 +225,7 @@ func resourceLaceworkAlertChannelJiraCloudRead(d *schema.ResourceData, meta inte
 
 func resourceLaceworkAlertChannelJiraCloudUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		jiraData           = api.JiraDataV2{
 			JiraUrl:       d.Get("jira_url").(string),
@@ -232,7 +234,7 @@ func resourceLaceworkAlertChannelJiraCloudUpdate(d *schema.ResourceData, meta in
 			IssueGrouping: d.Get("group_issues_by").(string),
 			ProjectID:     d.Get("project_key").(string),
 			Username:      d.Get("username").(string),
-			ApiToken:      d.Get("api_token").(string),
+			ApiToken:      getWriteOnlyString(d, "api_token"),
 			JiraType:      api.JiraCloudAlertType,
 		}
 	)
@@ -276,7 +278,7 @@ func resourceLaceworkAlertChannelJiraCloudUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelJiraCloudDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.JiraAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -17,7 +17,7 @@ func resourceLaceworkAlertChannelJiraServer() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelJiraServerDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkJiraAlertChannel(api.JiraServerAlertType),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,8 +56,10 @@ func resourceLaceworkAlertChannelJiraServer() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
 				Description: "The password to the Jira user",
 			},
+			"password_version": secretVersionSchema("password"),
 			"custom_template_file": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -131,7 +133,7 @@ func resourceLaceworkAlertChannelJiraServer() *schema.Resource {
 
 func resourceLaceworkAlertChannelJiraServerCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		jiraData           = api.JiraDataV2{
 			JiraUrl:       d.Get("jira_url").(string),
@@ -140,7 +142,7 @@ func resourceLaceworkAlertChannelJiraServerCreate(d *schema.ResourceData, meta i
 			IssueGrouping: d.Get("group_issues_by").(string),
 			ProjectID:     d.Get("project_key").(string),
 			Username:      d.Get("username").(string),
-			Password:      d.Get("password").(string),
+			Password:      getWriteOnlyString(d, "password"),
 			JiraType:      api.JiraServerAlertType,
 		}
 	)
@@ -183,7 +185,7 @@ func resourceLaceworkAlertChannelJiraServerCreate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelJiraServerRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.JiraAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetJira(d.Id())
@@ -221,7 +223,7 @@ func resourceLaceworkAlertChannelJiraServerRead(d *schema.ResourceData, meta int
 
 func resourceLaceworkAlertChannelJiraServerUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		jiraData           = api.JiraDataV2{
 			JiraUrl:       d.Get("jira_url").(string),
@@ -230,7 +232,7 @@ func resourceLaceworkAlertChannelJiraServerUpdate(d *schema.ResourceData, meta i
 			IssueGrouping: d.Get("group_issues_by").(string),
 			ProjectID:     d.Get("project_key").(string),
 			Username:      d.Get("username").(string),
-			Password:      d.Get("password").(string),
+			Password:      getWriteOnlyString(d, "password"),
 			JiraType:      api.JiraServerAlertType,
 		}
 	)
@@ -274,7 +276,7 @@ func resourceLaceworkAlertChannelJiraServerUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelJiraServerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.JiraAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -15,7 +15,7 @@ func resourceLaceworkAlertChannelMicrosoftTeams() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelMicrosoftTeamsDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.MicrosoftTeamsAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,7 +68,7 @@ func resourceLaceworkAlertChannelMicrosoftTeams() *schema.Resource {
 
 func resourceLaceworkAlertChannelMicrosoftTeamsCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework       = meta.(*api.Client)
+		lacework       = meta.(*providerMeta).client
 		microsoftTeams = api.NewAlertChannel(d.Get("name").(string),
 			api.MicrosoftTeamsAlertChannelType,
 			api.MicrosoftTeamsData{
@@ -109,7 +109,7 @@ func resourceLaceworkAlertChannelMicrosoftTeamsCreate(d *schema.ResourceData, me
 }
 
 func resourceLaceworkAlertChannelMicrosoftTeamsRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.MicrosoftTeamsAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetMicrosoftTeams(d.Id())
@@ -133,7 +133,7 @@ func resourceLaceworkAlertChannelMicrosoftTeamsRead(d *schema.ResourceData, meta
 
 func resourceLaceworkAlertChannelMicrosoftTeamsUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework       = meta.(*api.Client)
+		lacework       = meta.(*providerMeta).client
 		microsoftTeams = api.NewAlertChannel(d.Get("name").(string),
 			api.MicrosoftTeamsAlertChannelType,
 			api.MicrosoftTeamsData{
@@ -176,7 +176,7 @@ func resourceLaceworkAlertChannelMicrosoftTeamsUpdate(d *schema.ResourceData, me
 }
 
 func resourceLaceworkAlertChannelMicrosoftTeamsDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.MicrosoftTeamsAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -15,7 +15,7 @@ func resourceLaceworkAlertChannelNewRelic() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelNewRelicDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.NewRelicInsightsAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -38,8 +38,11 @@ func resourceLaceworkAlertChannelNewRelic() *schema.Resource {
 			"insert_key": {
 				Type:        schema.TypeString,
 				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
 				Description: "The New Relic Insert API key",
 			},
+			"insert_key_version": secretVersionSchema("insert_key"),
 			"test_integration": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -72,12 +75,12 @@ func resourceLaceworkAlertChannelNewRelic() *schema.Resource {
 
 func resourceLaceworkAlertChannelNewRelicCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		relic    = api.NewAlertChannel(d.Get("name").(string),
 			api.NewRelicInsightsAlertChannelType,
 			api.NewRelicInsightsDataV2{
 				AccountID: d.Get("account_id").(int),
-				InsertKey: d.Get("insert_key").(string),
+				InsertKey: getWriteOnlyString(d, "insert_key"),
 			},
 		)
 	)
@@ -114,7 +117,7 @@ func resourceLaceworkAlertChannelNewRelicCreate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkAlertChannelNewRelicRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.NewRelicInsightsAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetNewRelicInsights(d.Id())
@@ -132,7 +135,6 @@ func resourceLaceworkAlertChannelNewRelicRead(d *schema.ResourceData, meta inter
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
 	d.Set("account_id", integration.Data.AccountID)
-	d.Set("insert_key", integration.Data.InsertKey)
 
 	log.Printf("[INFO] Read %s integration with guid %s\n",
 		api.NewRelicInsightsAlertChannelType, integration.IntgGuid)
@@ -141,12 +143,12 @@ func resourceLaceworkAlertChannelNewRelicRead(d *schema.ResourceData, meta inter
 
 func resourceLaceworkAlertChannelNewRelicUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		relic    = api.NewAlertChannel(d.Get("name").(string),
 			api.NewRelicInsightsAlertChannelType,
 			api.NewRelicInsightsDataV2{
 				AccountID: d.Get("account_id").(int),
-				InsertKey: d.Get("insert_key").(string),
+				InsertKey: getWriteOnlyString(d, "insert_key"),
 			},
 		)
 	)
@@ -185,7 +187,7 @@ func resourceLaceworkAlertChannelNewRelicUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkAlertChannelNewRelicDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.NewRelicInsightsAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
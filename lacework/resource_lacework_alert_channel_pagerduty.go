@@ -16,7 +16,7 @@ func resourceLaceworkAlertChannelPagerDuty() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelPagerDutyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.PagerDutyApiAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,8 +35,10 @@ func resourceLaceworkAlertChannelPagerDuty() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
 				Description: "The PagerDuty service integration key",
 			},
+			"integration_key_version": secretVersionSchema("integration_key"),
 			"test_integration": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -69,11 +71,11 @@ func resourceLaceworkAlertChannelPagerDuty() *schema.Resource {
 
 func resourceLaceworkAlertChannelPagerDutyCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		alert    = api.NewAlertChannel(d.Get("name").(string),
 			api.PagerDutyApiAlertChannelType,
 			api.PagerDutyApiDataV2{
-				IntegrationKey: d.Get("integration_key").(string),
+				IntegrationKey: getWriteOnlyString(d, "integration_key"),
 			},
 		)
 	)
@@ -111,7 +113,7 @@ func resourceLaceworkAlertChannelPagerDutyCreate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelPagerDutyRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.PagerDutyApiAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetPagerDutyApi(d.Id())
@@ -135,11 +137,11 @@ func resourceLaceworkAlertChannelPagerDutyRead(d *schema.ResourceData, meta inte
 
 func resourceLaceworkAlertChannelPagerDutyUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		alert    = api.NewAlertChannel(d.Get("name").(string),
 			api.PagerDutyApiAlertChannelType,
 			api.PagerDutyApiDataV2{
-				IntegrationKey: d.Get("integration_key").(string),
+				IntegrationKey: getWriteOnlyString(d, "integration_key"),
 			},
 		)
 	)
@@ -179,7 +181,7 @@ func resourceLaceworkAlertChannelPagerDutyUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelPagerDutyDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.PagerDutyApiAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
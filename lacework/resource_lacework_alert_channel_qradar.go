@@ -16,7 +16,7 @@ func resourceLaceworkAlertChannelQRadar() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelQRadarDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.IbmQRadarAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,7 +101,7 @@ func resourceLaceworkAlertChannelQRadarCreate(d *schema.ResourceData, meta inter
 	comm, _ := api.QRadarComm(d.Get("communication_type").(string))
 
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		qradar   = api.NewAlertChannel(d.Get("name").(string),
 			api.IbmQRadarAlertChannelType,
 			api.IbmQRadarDataV2{
@@ -145,7 +145,7 @@ func resourceLaceworkAlertChannelQRadarCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkAlertChannelQRadarRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.IbmQRadarAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetIbmQRadar(d.Id())
@@ -174,7 +174,7 @@ func resourceLaceworkAlertChannelQRadarUpdate(d *schema.ResourceData, meta inter
 	comm, _ := api.QRadarComm(d.Get("communication_type").(string))
 
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		qradar   = api.NewAlertChannel(d.Get("name").(string),
 			api.IbmQRadarAlertChannelType,
 			api.IbmQRadarDataV2{
@@ -220,7 +220,7 @@ func resourceLaceworkAlertChannelQRadarUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkAlertChannelQRadarDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.IbmQRadarAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -16,7 +16,7 @@ func resourceLaceworkAlertChannelServiceNow() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelServiceNowDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.ServiceNowRestAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -45,8 +45,10 @@ func resourceLaceworkAlertChannelServiceNow() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
 				Description: "The ServiceNow password",
 			},
+			"password_version": secretVersionSchema("password"),
 			"issue_grouping": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -102,12 +104,12 @@ func resourceLaceworkAlertChannelServiceNow() *schema.Resource {
 
 func resourceLaceworkAlertChannelServiceNowCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		snowData           = api.ServiceNowRestDataV2{
 			InstanceURL:   d.Get("instance_url").(string),
 			Username:      d.Get("username").(string),
-			Password:      d.Get("password").(string),
+			Password:      getWriteOnlyString(d, "password"),
 			IssueGrouping: d.Get("issue_grouping").(string),
 		}
 	)
@@ -144,7 +146,7 @@ func resourceLaceworkAlertChannelServiceNowCreate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelServiceNowRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.ServiceNowRestAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetServiceNowRest(d.Id())
@@ -170,12 +172,12 @@ func resourceLaceworkAlertChannelServiceNowRead(d *schema.ResourceData, meta int
 
 func resourceLaceworkAlertChannelServiceNowUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		customTemplateJSON = d.Get("custom_template_file").(string)
 		snowData           = api.ServiceNowRestDataV2{
 			InstanceURL:   d.Get("instance_url").(string),
 			Username:      d.Get("username").(string),
-			Password:      d.Get("password").(string),
+			Password:      getWriteOnlyString(d, "password"),
 			IssueGrouping: d.Get("issue_grouping").(string),
 		}
 	)
@@ -221,7 +223,7 @@ func resourceLaceworkAlertChannelServiceNowUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkAlertChannelServiceNowDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.ServiceNowRestAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -16,7 +16,7 @@ func resourceLaceworkAlertChannelSplunk() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelSplunkDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.SplunkHecAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -39,8 +39,11 @@ func resourceLaceworkAlertChannelSplunk() *schema.Resource {
 			"hec_token": {
 				Type:        schema.TypeString,
 				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
 				Description: "The token you generate when you create a new HEC input",
 			},
+			"hec_token_version": secretVersionSchema("hec_token"),
 			"host": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -115,12 +118,12 @@ func resourceLaceworkAlertChannelSplunk() *schema.Resource {
 
 func resourceLaceworkAlertChannelSplunkCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		splunk   = api.NewAlertChannel(d.Get("name").(string),
 			api.SplunkHecAlertChannelType,
 			api.SplunkHecDataV2{
 				Channel:  d.Get("channel").(string),
-				HecToken: d.Get("hec_token").(string),
+				HecToken: getWriteOnlyString(d, "hec_token"),
 				Host:     d.Get("host").(string),
 				Port:     d.Get("port").(int),
 				Ssl:      d.Get("ssl").(bool),
@@ -164,7 +167,7 @@ func resourceLaceworkAlertChannelSplunkCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkAlertChannelSplunkRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.SplunkHecAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetSplunkHec(d.Id())
@@ -181,7 +184,6 @@ func resourceLaceworkAlertChannelSplunkRead(d *schema.ResourceData, meta interfa
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
 	d.Set("channel", integration.Data.Channel)
-	d.Set("hec_token", integration.Data.HecToken)
 	d.Set("host", integration.Data.Host)
 	d.Set("port", integration.Data.Port)
 	d.Set("ssl", integration.Data.Ssl)
@@ -199,12 +201,12 @@ func resourceLaceworkAlertChannelSplunkRead(d *schema.ResourceData, meta interfa
 
 func resourceLaceworkAlertChannelSplunkUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		splunk   = api.NewAlertChannel(d.Get("name").(string),
 			api.SplunkHecAlertChannelType,
 			api.SplunkHecDataV2{
 				Channel:  d.Get("channel").(string),
-				HecToken: d.Get("hec_token").(string),
+				HecToken: getWriteOnlyString(d, "hec_token"),
 				Host:     d.Get("host").(string),
 				Port:     d.Get("port").(int),
 				Ssl:      d.Get("ssl").(bool),
@@ -250,7 +252,7 @@ func resourceLaceworkAlertChannelSplunkUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkAlertChannelSplunkDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.SplunkHecAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -15,7 +15,7 @@ func resourceLaceworkAlertChannelVictorOps() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelVictorOpsDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.VictorOpsAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,7 +67,7 @@ func resourceLaceworkAlertChannelVictorOps() *schema.Resource {
 
 func resourceLaceworkAlertChannelVictorOpsCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		victor   = api.NewAlertChannel(d.Get("name").(string),
 			api.VictorOpsAlertChannelType,
 			api.VictorOpsDataV2{
@@ -108,7 +108,7 @@ func resourceLaceworkAlertChannelVictorOpsCreate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelVictorOpsRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid %s\n", api.VictorOpsAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetVictorOps(d.Id())
@@ -139,7 +139,7 @@ func resourceLaceworkAlertChannelVictorOpsRead(d *schema.ResourceData, meta inte
 
 func resourceLaceworkAlertChannelVictorOpsUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		victor   = api.NewAlertChannel(d.Get("name").(string),
 			api.VictorOpsAlertChannelType,
 			api.VictorOpsDataV2{
@@ -182,7 +182,7 @@ func resourceLaceworkAlertChannelVictorOpsUpdate(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkAlertChannelVictorOpsDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.VictorOpsAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
@@ -15,7 +15,7 @@ func resourceLaceworkAlertChannelWebhook() *schema.Resource {
 		Delete: resourceLaceworkAlertChannelWebhookDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkAlertChannel(api.WebhookAlertChannelType.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,7 +67,7 @@ func resourceLaceworkAlertChannelWebhook() *schema.Resource {
 
 func resourceLaceworkAlertChannelWebhookCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		webhook  = api.NewAlertChannel(d.Get("name").(string),
 			api.WebhookAlertChannelType,
 			api.WebhookDataV2{
@@ -109,7 +109,7 @@ func resourceLaceworkAlertChannelWebhookCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelWebhookRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n", api.WebhookAlertChannelType, d.Id())
 	response, err := lacework.V2.AlertChannels.GetWebhook(d.Id())
@@ -133,7 +133,7 @@ func resourceLaceworkAlertChannelWebhookRead(d *schema.ResourceData, meta interf
 
 func resourceLaceworkAlertChannelWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		webhook  = api.NewAlertChannel(d.Get("name").(string),
 			api.WebhookAlertChannelType,
 			api.WebhookDataV2{
@@ -177,7 +177,7 @@ func resourceLaceworkAlertChannelWebhookUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkAlertChannelWebhookDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid %s\n", api.WebhookAlertChannelType, d.Id())
 	err := lacework.V2.AlertChannels.Delete(d.Id())
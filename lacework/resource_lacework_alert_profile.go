@@ -77,7 +77,7 @@ func resourceLaceworkAlertProfile() *schema.Resource {
 
 func resourceLaceworkAlertProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		alerts   []api.AlertTemplate
 	)
 	err := castSchemaSetToArrayOfAlertTemplate(d, "alert", &alerts)
@@ -103,7 +103,7 @@ func resourceLaceworkAlertProfileCreate(d *schema.ResourceData, meta interface{}
 
 func resourceLaceworkAlertProfileRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		response api.AlertProfileResponse
 	)
 
@@ -125,7 +125,7 @@ func resourceLaceworkAlertProfileRead(d *schema.ResourceData, meta interface{})
 
 func resourceLaceworkAlertProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		alerts   []api.AlertTemplate
 	)
 	profileID := d.Get("name").(string)
@@ -148,7 +148,7 @@ func resourceLaceworkAlertProfileUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLaceworkAlertProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting alert profile with id: %s\n", d.Id())
 	err := lacework.V2.Alert.Profiles.Delete(d.Id())
@@ -162,7 +162,7 @@ func resourceLaceworkAlertProfileDelete(d *schema.ResourceData, meta interface{}
 
 func importLaceworkAlertProfile(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	var response api.AlertProfileResponse
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Alert Profile with id: %s\n", d.Id())
 
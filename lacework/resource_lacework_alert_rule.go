@@ -176,7 +176,7 @@ func resourceLaceworkAlertRuleCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	var (
-		lacework        = meta.(*api.Client)
+		lacework        = meta.(*providerMeta).client
 		resourceGroups  = d.Get("resource_groups").(*schema.Set).List()
 		alertCategories = d.Get("alert_categories").(*schema.Set).List()
 		alertSources    = d.Get("alert_sources").(*schema.Set).List()
@@ -218,7 +218,7 @@ func resourceLaceworkAlertRuleCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceLaceworkAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		response api.AlertRuleResponse
 	)
 
@@ -265,7 +265,7 @@ func resourceLaceworkAlertRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	var (
-		lacework        = meta.(*api.Client)
+		lacework        = meta.(*providerMeta).client
 		resourceGroups  = d.Get("resource_groups").(*schema.Set).List()
 		alertCategories = d.Get("alert_categories").(*schema.Set).List()
 		alertSources    = d.Get("alert_sources").(*schema.Set).List()
@@ -310,7 +310,7 @@ func resourceLaceworkAlertRuleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLaceworkAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting alert rule with guid %s\n", d.Id())
 	err := lacework.V2.AlertRules.Delete(d.Id())
@@ -324,7 +324,13 @@ func resourceLaceworkAlertRuleDelete(d *schema.ResourceData, meta interface{}) e
 
 func importLaceworkAlertRule(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	var response api.AlertRuleResponse
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
+
+	guid, err := resolveAlertRuleImportID(d.Id(), lacework)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(guid)
 
 	log.Printf("[INFO] Importing Lacework Alert Rule with guid: %s\n", d.Id())
 
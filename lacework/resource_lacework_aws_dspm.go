@@ -13,12 +13,12 @@ import (
 
 func resourceLaceworkAwsDspm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkAwsDspmCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkAwsDspmCreate),
 		Read:   resourceLaceworkAwsDspmRead,
-		Update: resourceLaceworkAwsDspmUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkAwsDspmUpdate),
 		Delete: resourceLaceworkAwsDspmDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AwsDspmCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +101,8 @@ func resourceLaceworkAwsDspm() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 			"retries": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -169,7 +171,7 @@ func resourceLaceworkAwsDspm() *schema.Resource {
 
 func resourceLaceworkAwsDspmCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 	)
 
@@ -229,6 +231,7 @@ func resourceLaceworkAwsDspmCreate(d *schema.ResourceData, meta interface{}) err
 		d.Set("intg_guid", cloudAccount.IntgGuid)
 		d.Set("name", cloudAccount.Name)
 		d.Set("server_token", cloudAccount.ServerToken)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		if err := updateDspmStatus(d, lacework, cloudAccount.ServerToken); err != nil {
 			log.Printf("[WARN] Failed to update DSPM status: %s", err)
@@ -241,7 +244,7 @@ func resourceLaceworkAwsDspmCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceLaceworkAwsDspmRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client := meta.(*providerMeta).client
 	guid := d.Id()
 
 	log.Printf("[INFO] Reading DSPM configuration %s", guid)
@@ -255,6 +258,7 @@ func resourceLaceworkAwsDspmRead(d *schema.ResourceData, meta interface{}) error
 	if cloudAccount.IntgGuid == d.Id() {
 		d.Set("name", cloudAccount.Name)
 		d.Set("intg_guid", cloudAccount.IntgGuid)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 		d.Set("account_id", dspmData.AccountID)
 		d.Set("storage_bucket_arn", dspmData.BucketArn)
 		creds := make(map[string]string)
@@ -278,7 +282,7 @@ func resourceLaceworkAwsDspmRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceLaceworkAwsDspmUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	integrationLevel := api.AwsAccountIntegration
@@ -328,7 +332,7 @@ func resourceLaceworkAwsDspmUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceLaceworkAwsDspmDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AwsDspmCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
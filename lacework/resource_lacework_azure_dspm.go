@@ -13,12 +13,12 @@ import (
 
 func resourceLaceworkAzureDspm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkAzureDspmCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkAzureDspmCreate),
 		Read:   resourceLaceworkAzureDspmRead,
-		Update: resourceLaceworkAzureDspmUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkAzureDspmUpdate),
 		Delete: resourceLaceworkAzureDspmDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AzureDspmCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -107,8 +107,11 @@ func resourceLaceworkAzureDspm() *schema.Resource {
 						"client_secret": {
 							Type:        schema.TypeString,
 							Required:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
 							Description: "The client secret of the Azure SP used by Lacework platform.",
 						},
+						"client_secret_version": secretVersionSchema("client_secret"),
 					},
 				},
 			},
@@ -124,6 +127,8 @@ func resourceLaceworkAzureDspm() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 			"retries": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -169,7 +174,7 @@ func resourceLaceworkAzureDspm() *schema.Resource {
 
 func resourceLaceworkAzureDspmCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 	)
 
@@ -185,7 +190,7 @@ func resourceLaceworkAzureDspmCreate(d *schema.ResourceData, meta interface{}) e
 		BlobContainerName: d.Get("blob_container_name").(string),
 		Credentials: api.AzureDspmCredentials{
 			ClientId:     d.Get("credentials.0.client_id").(string),
-			ClientSecret: d.Get("credentials.0.client_secret").(string),
+			ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 		},
 		Regions: castAttributeToStringSlice(d, "regions"),
 	}
@@ -229,6 +234,7 @@ func resourceLaceworkAzureDspmCreate(d *schema.ResourceData, meta interface{}) e
 		d.Set("intg_guid", cloudAccount.IntgGuid)
 		d.Set("name", cloudAccount.Name)
 		d.Set("server_token", cloudAccount.ServerToken)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		if err := updateDspmStatus(d, lacework, cloudAccount.ServerToken); err != nil {
 			log.Printf("[WARN] Failed to update DSPM status: %s", err)
@@ -241,7 +247,7 @@ func resourceLaceworkAzureDspmCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLaceworkAzureDspmRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client := meta.(*providerMeta).client
 	guid := d.Id()
 
 	log.Printf("[INFO] Reading DSPM configuration %s", guid)
@@ -257,14 +263,15 @@ func resourceLaceworkAzureDspmRead(d *schema.ResourceData, meta interface{}) err
 	if cloudAccount.IntgGuid == d.Id() {
 		d.Set("name", cloudAccount.Name)
 		d.Set("intg_guid", cloudAccount.IntgGuid)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 		d.Set("tenant_id", dspmData.TenantID)
 		d.Set("integration_level", dspmData.IntegrationLevel)
 		d.Set("storage_account_url", dspmData.StorageAccountUrl)
 		d.Set("blob_container_name", dspmData.BlobContainerName)
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = cloudAccount.Data.Credentials.ClientId
-		creds["client_secret"] = cloudAccount.Data.Credentials.ClientSecret
-		d.Set("credentials", []map[string]string{creds})
+		creds["client_secret_version"] = d.Get("credentials.0.client_secret_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("regions", dspmData.Regions)
 		readDspmProps(d, cloudAccount.Props, "subscription_filters", "subscription_ids")
 		log.Printf("[INFO] Read %s cloud account integration with guid: %v\n",
@@ -279,7 +286,7 @@ func resourceLaceworkAzureDspmRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceLaceworkAzureDspmUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	integrationLevel := api.AzureSubscriptionIntegration
@@ -294,7 +301,7 @@ func resourceLaceworkAzureDspmUpdate(d *schema.ResourceData, meta interface{}) e
 		BlobContainerName: d.Get("blob_container_name").(string),
 		Credentials: api.AzureDspmCredentials{
 			ClientId:     d.Get("credentials.0.client_id").(string),
-			ClientSecret: d.Get("credentials.0.client_secret").(string),
+			ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 		},
 		Regions: castAttributeToStringSlice(d, "regions"),
 	}
@@ -329,7 +336,7 @@ func resourceLaceworkAzureDspmUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLaceworkAzureDspmDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AzureDspmCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
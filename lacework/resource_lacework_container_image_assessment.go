@@ -0,0 +1,340 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// containerAssessmentSeverities are the severities with counts and thresholds
+var containerAssessmentSeverities = []string{"critical", "high", "medium", "low", "info"}
+
+func resourceLaceworkContainerImageAssessment() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"registry": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The registry of the image, as configured in a container registry integration",
+		},
+		"repository": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The repository of the image",
+		},
+		"tag": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "The tag of the image to assess",
+			AtLeastOneOf: []string{"tag", "digest"},
+		},
+		"digest": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Description: "The digest of the image to assess, a new assessment is triggered when it changes. " +
+				"Required to gate deployments, when not set the digest of the image the tag pointed to when it " +
+				"was assessed is exported, and the image is not assessed again when the tag moves",
+			AtLeastOneOf: []string{"tag", "digest"},
+		},
+		"request_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The id of the scan request",
+		},
+		"eval_guid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The guid of the vulnerability assessment",
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The id of the assessed image",
+		},
+		"total_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of vulnerabilities found in the image, excluding excepted vulnerabilities",
+		},
+		"total_fixable_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of fixable vulnerabilities found in the image, excluding excepted vulnerabilities",
+		},
+		"excepted_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of vulnerabilities matching a vulnerability exception",
+		},
+	}
+
+	for _, severity := range containerAssessmentSeverities {
+		resourceSchema[severity+"_count"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("The number of %s vulnerabilities, excluding excepted vulnerabilities", severity),
+		}
+		resourceSchema[severity+"_fixable_count"] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+			Description: fmt.Sprintf(
+				"The number of fixable %s vulnerabilities, excluding excepted vulnerabilities", severity,
+			),
+		}
+		resourceSchema["max_"+severity] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.IntAtLeast(-1),
+			Description: fmt.Sprintf(
+				"The maximum number of %s vulnerabilities allowed, the apply fails when it is exceeded. "+
+					"Defaults to -1 (no limit)", severity,
+			),
+		}
+		resourceSchema["max_"+severity+"_fixable"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.IntAtLeast(-1),
+			Description: fmt.Sprintf(
+				"The maximum number of fixable %s vulnerabilities allowed, the apply fails when it is exceeded. "+
+					"Defaults to -1 (no limit)", severity,
+			),
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceLaceworkContainerImageAssessmentCreate,
+		Read:   resourceLaceworkContainerImageAssessmentRead,
+		Update: resourceLaceworkContainerImageAssessmentUpdate,
+		Delete: resourceLaceworkContainerImageAssessmentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceLaceworkContainerImageAssessmentCreate(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework   = meta.(*providerMeta).client
+		registry   = d.Get("registry").(string)
+		repository = d.Get("repository").(string)
+		tagOrHash  = d.Get("tag").(string)
+		scanTime   = time.Now().UTC()
+	)
+	if digest, ok := d.GetOk("digest"); ok {
+		tagOrHash = digest.(string)
+	}
+
+	log.Printf("[INFO] Requesting assessment of container image %s/%s@%s\n", registry, repository, tagOrHash)
+	scan, err := lacework.V2.Vulnerabilities.Containers.Scan(registry, repository, tagOrHash)
+	if err != nil {
+		return fmt.Errorf("unable to request the assessment of container image %s/%s@%s: %s",
+			registry, repository, tagOrHash, err)
+	}
+	requestID := scan.Data.RequestID
+	d.Set("request_id", requestID)
+
+	var evalGuid string
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		status, err := lacework.V2.Vulnerabilities.Containers.ScanStatus(requestID)
+		if err != nil {
+			return retry.NonRetryableError(
+				fmt.Errorf("unable to read the status of the assessment with request id %s: %s", requestID, err),
+			)
+		}
+
+		switch status.CheckStatus() {
+		case "Success":
+			evalGuid = status.Data.EvalGuid
+			return nil
+		case "Failed", "NotFound":
+			return retry.NonRetryableError(fmt.Errorf(
+				"the assessment of container image %s/%s@%s failed with status %s: %s",
+				registry, repository, tagOrHash, status.CheckStatus(), status.Message,
+			))
+		default:
+			return retry.RetryableError(fmt.Errorf(
+				"the assessment with request id %s did not complete after %s, last status: %s",
+				requestID, timeout, status.CheckStatus(),
+			))
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if evalGuid == "" {
+		return fmt.Errorf("the assessment with request id %s completed without an evaluation guid", requestID)
+	}
+
+	vulnerabilities, err := searchContainerAssessment(lacework, evalGuid, scanTime)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(evalGuid)
+	d.Set("eval_guid", evalGuid)
+	if len(vulnerabilities) != 0 {
+		d.Set("image_id", vulnerabilities[0].ImageID)
+		if _, ok := d.GetOk("digest"); !ok {
+			d.Set("digest", vulnerabilities[0].EvalCtx.ImageInfo.Digest)
+		}
+	}
+
+	counts := countContainerAssessment(vulnerabilities)
+	for key, count := range counts {
+		d.Set(key, count)
+	}
+	log.Printf("[INFO] Assessed container image %s/%s@%s with eval guid %s: %v\n",
+		registry, repository, tagOrHash, evalGuid, counts)
+
+	// a failed create taints the resource, the next apply triggers a new assessment
+	return checkContainerAssessmentThresholds(d, counts)
+}
+
+// resourceLaceworkContainerImageAssessmentRead keeps the counts of the assessment, an
+// assessment is a snapshot of the vulnerabilities of the image at the time of the scan
+func resourceLaceworkContainerImageAssessmentRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// resourceLaceworkContainerImageAssessmentUpdate checks the counts of the assessment
+// against the updated thresholds, the image is only assessed again when it changes
+func resourceLaceworkContainerImageAssessmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	counts := map[string]int{}
+	for _, severity := range containerAssessmentSeverities {
+		counts[severity+"_count"] = d.Get(severity + "_count").(int)
+		counts[severity+"_fixable_count"] = d.Get(severity + "_fixable_count").(int)
+	}
+
+	if err := checkContainerAssessmentThresholds(d, counts); err != nil {
+		// keep the previous thresholds so the next apply checks the new ones again
+		d.Partial(true)
+		return err
+	}
+	return nil
+}
+
+func resourceLaceworkContainerImageAssessmentDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Removing assessment with eval guid %s from the state\n", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// containerAssessmentClockSkew is subtracted from the time the scan was requested to
+// search its vulnerabilities, in case the clock of the Lacework platform is behind
+const containerAssessmentClockSkew = 5 * time.Minute
+
+// searchContainerAssessment returns the vulnerabilities found by an assessment requested at scanTime
+func searchContainerAssessment(lacework *api.Client, evalGuid string,
+	scanTime time.Time) ([]api.VulnerabilityContainer, error) {
+	var (
+		start = scanTime.Add(-containerAssessmentClockSkew)
+		now   = time.Now().UTC()
+	)
+
+	response, err := lacework.V2.Vulnerabilities.Containers.SearchAllPages(api.SearchFilter{
+		TimeFilter: &api.TimeFilter{
+			StartTime: &start,
+			EndTime:   &now,
+		},
+		Filters: []api.Filter{{
+			Field:      "evalGuid",
+			Expression: "eq",
+			Value:      evalGuid,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the vulnerabilities of the assessment with eval guid %s: %s",
+			evalGuid, err)
+	}
+	return response.Data, nil
+}
+
+// countContainerAssessment counts the vulnerabilities of an assessment per severity, the
+// vulnerabilities that match a vulnerability exception are only counted as excepted
+func countContainerAssessment(vulnerabilities []api.VulnerabilityContainer) map[string]int {
+	counts := map[string]int{
+		"total_count":         0,
+		"total_fixable_count": 0,
+		"excepted_count":      0,
+	}
+	for _, severity := range containerAssessmentSeverities {
+		counts[severity+"_count"] = 0
+		counts[severity+"_fixable_count"] = 0
+	}
+
+	for _, vuln := range vulnerabilities {
+		if isContainerVulnerabilityExcepted(vuln) {
+			counts["excepted_count"]++
+			continue
+		}
+		if vuln.Status != "VULNERABLE" {
+			continue
+		}
+
+		severity := strings.ToLower(vuln.Severity)
+		fixable := vuln.FixInfo.FixAvailable == 1
+		counts["total_count"]++
+		if fixable {
+			counts["total_fixable_count"]++
+		}
+		if _, ok := counts[severity+"_count"]; ok {
+			counts[severity+"_count"]++
+			if fixable {
+				counts[severity+"_fixable_count"]++
+			}
+		}
+	}
+	return counts
+}
+
+func isContainerVulnerabilityExcepted(vuln api.VulnerabilityContainer) bool {
+	if strings.EqualFold(vuln.Status, "EXCEPTION") {
+		return true
+	}
+	for _, exception := range vuln.EvalCtx.ExceptionProps {
+		if exception.Status != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// checkContainerAssessmentThresholds fails when the counts of an assessment exceed
+// the configured thresholds, a threshold of -1 means no limit
+func checkContainerAssessmentThresholds(d *schema.ResourceData, counts map[string]int) error {
+	var exceeded []string
+	for _, severity := range containerAssessmentSeverities {
+		if max := d.Get("max_" + severity).(int); max >= 0 && counts[severity+"_count"] > max {
+			exceeded = append(exceeded, fmt.Sprintf("%d %s vulnerabilities (max_%s = %d)",
+				counts[severity+"_count"], severity, severity, max))
+		}
+		if max := d.Get("max_" + severity + "_fixable").(int); max >= 0 && counts[severity+"_fixable_count"] > max {
+			exceeded = append(exceeded, fmt.Sprintf("%d fixable %s vulnerabilities (max_%s_fixable = %d)",
+				counts[severity+"_fixable_count"], severity, severity, max))
+		}
+	}
+
+	if len(exceeded) != 0 {
+		return fmt.Errorf("container image %s/%s assessment %s exceeds the vulnerability thresholds: %s",
+			d.Get("registry"), d.Get("repository"), d.Id(), strings.Join(exceeded, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func containerAssessmentVulnerability(severity, status string, fixable bool) api.VulnerabilityContainer {
+	vuln := api.VulnerabilityContainer{Severity: severity, Status: status}
+	if fixable {
+		vuln.FixInfo.FixAvailable = 1
+	}
+	return vuln
+}
+
+func TestCountContainerAssessment(t *testing.T) {
+	excepted := containerAssessmentVulnerability("Critical", "VULNERABLE", true)
+	excepted.EvalCtx.ExceptionProps = append(excepted.EvalCtx.ExceptionProps, struct {
+		Status string `json:"status"`
+	}{Status: "Active"})
+
+	counts := countContainerAssessment([]api.VulnerabilityContainer{
+		containerAssessmentVulnerability("Critical", "VULNERABLE", true),
+		containerAssessmentVulnerability("Critical", "VULNERABLE", false),
+		containerAssessmentVulnerability("High", "VULNERABLE", true),
+		containerAssessmentVulnerability("Medium", "GOOD", true),
+		containerAssessmentVulnerability("Low", "EXCEPTION", false),
+		excepted,
+	})
+
+	assert.Equal(t, 2, counts["critical_count"])
+	assert.Equal(t, 1, counts["critical_fixable_count"])
+	assert.Equal(t, 1, counts["high_count"])
+	assert.Equal(t, 1, counts["high_fixable_count"])
+	assert.Equal(t, 0, counts["medium_count"])
+	assert.Equal(t, 0, counts["low_count"])
+	assert.Equal(t, 3, counts["total_count"])
+	assert.Equal(t, 2, counts["total_fixable_count"])
+	assert.Equal(t, 2, counts["excepted_count"])
+}
+
+func TestCheckContainerAssessmentThresholds(t *testing.T) {
+	counts := countContainerAssessment([]api.VulnerabilityContainer{
+		containerAssessmentVulnerability("Critical", "VULNERABLE", false),
+		containerAssessmentVulnerability("High", "VULNERABLE", true),
+		containerAssessmentVulnerability("High", "VULNERABLE", true),
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceLaceworkContainerImageAssessment().Schema, map[string]interface{}{
+		"registry":   "index.docker.io",
+		"repository": "lacework/lacework-cli",
+		"tag":        "latest",
+	})
+	d.SetId("EVAL_GUID")
+	assert.NoError(t, checkContainerAssessmentThresholds(d, counts), "no thresholds by default")
+
+	d.Set("max_critical", 1)
+	d.Set("max_high", 2)
+	assert.NoError(t, checkContainerAssessmentThresholds(d, counts))
+
+	d.Set("max_critical", 0)
+	d.Set("max_high_fixable", 1)
+	assert.EqualError(t, checkContainerAssessmentThresholds(d, counts),
+		"container image index.docker.io/lacework/lacework-cli assessment EVAL_GUID exceeds the vulnerability "+
+			"thresholds: 1 critical vulnerabilities (max_critical = 0), 2 fixable high vulnerabilities (max_high_fixable = 1)")
+}
@@ -71,7 +71,7 @@ func resourceLaceworkDataExportRule() *schema.Resource {
 
 func resourceLaceworkDataExportRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		exportRule = api.DataExportRule{
 			Filter: api.DataExportRuleFilter{
 				Name:        d.Get("name").(string),
@@ -106,7 +106,7 @@ func resourceLaceworkDataExportRuleCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkDataExportRuleRead(d *schema.ResourceData, meta interface{}) error {
-	var lacework = meta.(*api.Client)
+	var lacework = meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading data export rule with guid %s\n", d.Id())
 	response, err := lacework.V2.DataExportRules.Get(d.Id())
@@ -130,7 +130,7 @@ func resourceLaceworkDataExportRuleRead(d *schema.ResourceData, meta interface{}
 
 func resourceLaceworkDataExportRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		exportRule = api.DataExportRule{
 			Filter: api.DataExportRuleFilter{
 				Name:        d.Get("name").(string),
@@ -167,7 +167,7 @@ func resourceLaceworkDataExportRuleUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkDataExportRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting data export rule with guid: %v\n", d.Id())
 	err := lacework.V2.DataExportRules.Delete(d.Id())
@@ -180,7 +180,7 @@ func resourceLaceworkDataExportRuleDelete(d *schema.ResourceData, meta interface
 }
 
 func importLaceworkDataExportRule(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Data Export Rule with guid: %s\n", d.Id())
 
@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 
-	"github.com/lacework/go-sdk/v2/api"
 	"github.com/lacework/go-sdk/v2/lwdomain"
 )
 
@@ -43,7 +42,7 @@ func resourceLaceworkExternalID() *schema.Resource {
 }
 
 func resourceLaceworkExternalIDCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	url, err := lwdomain.New(lacework.URL())
 	if err != nil {
 		return errors.Wrap(err, "Unable to get the Lacework account")
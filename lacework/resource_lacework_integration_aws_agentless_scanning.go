@@ -13,12 +13,12 @@ import (
 
 func resourceLaceworkIntegrationAwsAgentlessScanning() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceLaceworkIntegrationAwsAgentlessScanningCreate,
+		Create:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsAgentlessScanningCreate),
 		Read:     resourceLaceworkIntegrationAwsAgentlessScanningRead,
-		Update:   resourceLaceworkIntegrationAwsAgentlessScanningUpdate,
+		Update:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsAgentlessScanningUpdate),
 		Delete:   resourceLaceworkIntegrationAwsAgentlessScanningDelete,
 		Schema:   awsAgentlessScanningIntegrationSchema,
-		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Importer: &schema.ResourceImporter{StateContext: importLaceworkCloudAccount(api.AwsSidekickCloudAccount.String())},
 	}
 }
 
@@ -132,11 +132,13 @@ var awsAgentlessScanningIntegrationSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"state":            integrationStateSchema(),
+	"wait_for_healthy": integrationWaitForHealthySchema(),
 }
 
 func resourceLaceworkIntegrationAwsAgentlessScanningCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 	)
 
@@ -198,6 +200,7 @@ func resourceLaceworkIntegrationAwsAgentlessScanningCreate(d *schema.ResourceDat
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 		d.Set("server_token", cloudAccount.ServerToken)
 		d.Set("uri", cloudAccount.Uri)
 
@@ -208,7 +211,7 @@ func resourceLaceworkIntegrationAwsAgentlessScanningCreate(d *schema.ResourceDat
 }
 
 func resourceLaceworkIntegrationAwsAgentlessScanningRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.AwsSidekickCloudAccount.String(), d.Id())
 	response, err := lacework.V2.CloudAccounts.GetAwsSidekick(d.Id())
@@ -225,6 +228,7 @@ func resourceLaceworkIntegrationAwsAgentlessScanningRead(d *schema.ResourceData,
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		creds := make(map[string]string)
 		creds["role_arn"] = response.Data.Data.CrossAccountCreds.RoleArn
@@ -244,7 +248,7 @@ func resourceLaceworkIntegrationAwsAgentlessScanningRead(d *schema.ResourceData,
 
 func resourceLaceworkIntegrationAwsAgentlessScanningUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	awsAgentlessScanningData := api.AwsSidekickData{
@@ -290,13 +294,14 @@ func resourceLaceworkIntegrationAwsAgentlessScanningUpdate(d *schema.ResourceDat
 	d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 	d.Set("type_name", cloudAccount.Type)
 	d.Set("org_level", cloudAccount.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 	log.Printf("[INFO] Updated %s cloud account integration with guid: %v\n", api.AwsSidekickCloudAccount.String(), d.Id())
 	return nil
 }
 
 func resourceLaceworkIntegrationAwsAgentlessScanningDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AwsSidekickCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -13,13 +13,13 @@ import (
 
 func resourceLaceworkIntegrationAwsCfg() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationAwsCfgCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsCfgCreate),
 		Read:   resourceLaceworkIntegrationAwsCfgRead,
-		Update: resourceLaceworkIntegrationAwsCfgUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsCfgUpdate),
 		Delete: resourceLaceworkIntegrationAwsCfgDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AwsCfgCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -75,13 +75,15 @@ func resourceLaceworkIntegrationAwsCfg() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationAwsCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsCfgCloudAccount,
@@ -128,6 +130,7 @@ func resourceLaceworkIntegrationAwsCfgCreate(d *schema.ResourceData, meta interf
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Created %s integration with guid: %v\n",
 			api.AwsCfgCloudAccount.String(), integration.IntgGuid)
@@ -136,7 +139,7 @@ func resourceLaceworkIntegrationAwsCfgCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationAwsCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AwsCfgCloudAccount.String(), d.Id())
@@ -154,6 +157,7 @@ func resourceLaceworkIntegrationAwsCfgRead(d *schema.ResourceData, meta interfac
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		creds := make(map[string]string)
 		credentials := cloudAccount.Data.Credentials
@@ -172,7 +176,7 @@ func resourceLaceworkIntegrationAwsCfgRead(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkIntegrationAwsCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsCfgCloudAccount,
 			api.AwsCfgData{
@@ -204,6 +208,7 @@ func resourceLaceworkIntegrationAwsCfgUpdate(d *schema.ResourceData, meta interf
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Updated %s integration with guid: %v\n",
 		api.AwsCfgCloudAccount.String(), d.Id())
@@ -211,7 +216,7 @@ func resourceLaceworkIntegrationAwsCfgUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationAwsCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AwsCfgCloudAccount.String(), d.Id())
@@ -14,12 +14,12 @@ import (
 
 func resourceLaceworkIntegrationAwsCloudTrail() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceLaceworkIntegrationAwsCloudTrailCreate,
+		Create:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsCloudTrailCreate),
 		Read:     resourceLaceworkIntegrationAwsCloudTrailRead,
-		Update:   resourceLaceworkIntegrationAwsCloudTrailUpdate,
+		Update:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsCloudTrailUpdate),
 		Delete:   resourceLaceworkIntegrationAwsCloudTrailDelete,
 		Schema:   awsCloudTrailIntegrationSchema,
-		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Importer: &schema.ResourceImporter{StateContext: importLaceworkCloudAccount(api.AwsCtSqsCloudAccount.String())},
 	}
 }
 
@@ -120,11 +120,13 @@ var awsCloudTrailIntegrationSchema = map[string]*schema.Schema{
 		Type:     schema.TypeBool,
 		Computed: true,
 	},
+	"state":            integrationStateSchema(),
+	"wait_for_healthy": integrationWaitForHealthySchema(),
 }
 
 func resourceLaceworkIntegrationAwsCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework     = meta.(*api.Client)
+		lacework     = meta.(*providerMeta).client
 		retries      = d.Get("retries").(int)
 		awsCtSqsData = api.AwsCtSqsData{
 			QueueUrl: d.Get("queue_url").(string),
@@ -185,6 +187,7 @@ func resourceLaceworkIntegrationAwsCloudTrailCreate(d *schema.ResourceData, meta
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type) // @afiune should we deprecate?
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		log.Printf("[INFO] Created %s cloud account integration with guid: %v\n",
 			api.AwsCtSqsCloudAccount.String(), cloudAccount.IntgGuid)
@@ -193,7 +196,7 @@ func resourceLaceworkIntegrationAwsCloudTrailCreate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.AwsCtSqsCloudAccount.String(), d.Id())
 	response, err := lacework.V2.CloudAccounts.GetAwsCtSqs(d.Id())
@@ -210,6 +213,7 @@ func resourceLaceworkIntegrationAwsCloudTrailRead(d *schema.ResourceData, meta i
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		creds := make(map[string]string)
 		credentials := cloudAccount.Data.Credentials
@@ -251,7 +255,7 @@ func resourceLaceworkIntegrationAwsCloudTrailRead(d *schema.ResourceData, meta i
 
 func resourceLaceworkIntegrationAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework     = meta.(*api.Client)
+		lacework     = meta.(*providerMeta).client
 		awsCtSqsData = api.AwsCtSqsData{
 			QueueUrl: d.Get("queue_url").(string),
 			Credentials: api.AwsCtSqsCredentials{
@@ -297,13 +301,14 @@ func resourceLaceworkIntegrationAwsCloudTrailUpdate(d *schema.ResourceData, meta
 	d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 	d.Set("type_name", cloudAccount.Type)
 	d.Set("org_level", cloudAccount.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 	log.Printf("[INFO] Updated %s cloud account integration with guid: %v\n", api.AwsCtSqsCloudAccount.String(), d.Id())
 	return nil
 }
 
 func resourceLaceworkIntegrationAwsCloudTrailDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AwsCtSqsCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -13,12 +13,12 @@ import (
 
 func resourceLaceworkIntegrationAwsEksAuditLog() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceLaceworkIntegrationAwsEksAuditLogCreate,
+		Create:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsEksAuditLogCreate),
 		Read:     resourceLaceworkIntegrationAwsEksAuditLogRead,
-		Update:   resourceLaceworkIntegrationAwsEksAuditLogUpdate,
+		Update:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsEksAuditLogUpdate),
 		Delete:   resourceLaceworkIntegrationAwsEksAuditLogDelete,
 		Schema:   awsEksAuditLogIntegrationSchema,
-		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Importer: &schema.ResourceImporter{StateContext: importLaceworkCloudAccount(api.AwsEksAuditCloudAccount.String())},
 	}
 }
 
@@ -88,11 +88,13 @@ var awsEksAuditLogIntegrationSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"state":            integrationStateSchema(),
+	"wait_for_healthy": integrationWaitForHealthySchema(),
 }
 
 func resourceLaceworkIntegrationAwsEksAuditLogCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		retries            = d.Get("retries").(int)
 		awsEksAuditLogData = api.AwsEksAuditData{
 			SnsArn:      d.Get("sns_arn").(string),
@@ -144,6 +146,7 @@ func resourceLaceworkIntegrationAwsEksAuditLogCreate(d *schema.ResourceData, met
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type) // @afiune should we deprecate?
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		log.Printf("[INFO] Created %s cloud account integration with guid: %v\n",
 			api.AwsEksAuditCloudAccount.String(), cloudAccount.IntgGuid)
@@ -152,7 +155,7 @@ func resourceLaceworkIntegrationAwsEksAuditLogCreate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationAwsEksAuditLogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.AwsEksAuditCloudAccount.String(), d.Id())
 	response, err := lacework.V2.CloudAccounts.GetAwsEksAudit(d.Id())
@@ -169,6 +172,7 @@ func resourceLaceworkIntegrationAwsEksAuditLogRead(d *schema.ResourceData, meta
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		creds := make(map[string]string)
 		credentials := cloudAccount.Data.Credentials
@@ -192,7 +196,7 @@ func resourceLaceworkIntegrationAwsEksAuditLogRead(d *schema.ResourceData, meta
 
 func resourceLaceworkIntegrationAwsEksAuditLogUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		awsEksAuditLogData = api.AwsEksAuditData{
 			SnsArn:      d.Get("sns_arn").(string),
 			S3BucketArn: d.Get("s3_bucket_arn").(string),
@@ -228,6 +232,7 @@ func resourceLaceworkIntegrationAwsEksAuditLogUpdate(d *schema.ResourceData, met
 	d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 	d.Set("type_name", cloudAccount.Type)
 	d.Set("org_level", cloudAccount.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(cloudAccount.State))
 	d.Set("sns_arn", cloudAccount.Data.SnsArn)
 	d.Set("s3_bucket_arn", cloudAccount.Data.S3BucketArn)
 
@@ -236,7 +241,7 @@ func resourceLaceworkIntegrationAwsEksAuditLogUpdate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationAwsEksAuditLogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AwsEksAuditCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
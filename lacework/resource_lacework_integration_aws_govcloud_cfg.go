@@ -13,13 +13,13 @@ import (
 
 func resourceLaceworkIntegrationAwsGovCloudCfg() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationAwsGovCloudCfgCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsGovCloudCfgCreate),
 		Read:   resourceLaceworkIntegrationAwsGovCloudCfgRead,
-		Update: resourceLaceworkIntegrationAwsGovCloudCfgUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsGovCloudCfgUpdate),
 		Delete: resourceLaceworkIntegrationAwsGovCloudCfgDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AwsUsGovCfgCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -62,8 +62,10 @@ func resourceLaceworkIntegrationAwsGovCloudCfg() *schema.Resource {
 							Type:        schema.TypeString,
 							Required:    true,
 							Sensitive:   true,
+							WriteOnly:   true,
 							Description: "The AWS secret key for the specified AWS access key",
 						},
+						"secret_access_key_version": secretVersionSchema("secret_access_key"),
 					},
 				},
 			},
@@ -83,20 +85,22 @@ func resourceLaceworkIntegrationAwsGovCloudCfg() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsUsGovCfgCloudAccount,
 			api.AwsUsGovCfgData{
 				Credentials: api.AwsUsGovCfgCredentials{
 					AccessKeyID:     d.Get("credentials.0.access_key_id").(string),
-					SecretAccessKey: d.Get("credentials.0.secret_access_key").(string),
+					SecretAccessKey: getWriteOnlyString(d, "credentials.0.secret_access_key"),
 					AwsAccountID:    d.Get("account_id").(string),
 				},
 			},
@@ -138,6 +142,7 @@ func resourceLaceworkIntegrationAwsGovCloudCfgCreate(d *schema.ResourceData, met
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Created %s integration with guid: %v\n",
 			api.AwsUsGovCfgCloudAccount.String(), integration.IntgGuid)
@@ -146,7 +151,7 @@ func resourceLaceworkIntegrationAwsGovCloudCfgCreate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AwsUsGovCfgCloudAccount.String(), d.Id())
@@ -164,13 +169,14 @@ func resourceLaceworkIntegrationAwsGovCloudCfgRead(d *schema.ResourceData, meta
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 		d.Set("account_id", integration.Data.Credentials.AwsAccountID)
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		credentials := integration.Data.Credentials
 		creds["access_key_id"] = credentials.AccessKeyID
-		creds["secret_access_key"] = credentials.SecretAccessKey
-		d.Set("credentials", []map[string]string{creds})
+		creds["secret_access_key_version"] = d.Get("credentials.0.secret_access_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 
 		log.Printf("[INFO] Read %s integration with guid: %v\n",
 			api.AwsUsGovCfgCloudAccount.String(), integration.IntgGuid)
@@ -183,13 +189,13 @@ func resourceLaceworkIntegrationAwsGovCloudCfgRead(d *schema.ResourceData, meta
 
 func resourceLaceworkIntegrationAwsGovCloudCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsCfgCloudAccount,
 			api.AwsUsGovCfgData{
 				Credentials: api.AwsUsGovCfgCredentials{
 					AccessKeyID:     d.Get("credentials.0.access_key_id").(string),
-					SecretAccessKey: d.Get("credentials.0.secret_access_key").(string),
+					SecretAccessKey: getWriteOnlyString(d, "credentials.0.secret_access_key"),
 					AwsAccountID:    d.Get("account_id").(string),
 				},
 			},
@@ -217,6 +223,7 @@ func resourceLaceworkIntegrationAwsGovCloudCfgUpdate(d *schema.ResourceData, met
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Updated %s integration with guid: %v\n",
 		api.AwsUsGovCfgCloudAccount.String(), d.Id())
@@ -224,7 +231,7 @@ func resourceLaceworkIntegrationAwsGovCloudCfgUpdate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AwsUsGovCfgCloudAccount.String(), d.Id())
@@ -13,13 +13,13 @@ import (
 
 func resourceLaceworkIntegrationAwsGovCloudCT() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationAwsGovCloudCTCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsGovCloudCTCreate),
 		Read:   resourceLaceworkIntegrationAwsGovCloudCTRead,
-		Update: resourceLaceworkIntegrationAwsGovCloudCTUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsGovCloudCTUpdate),
 		Delete: resourceLaceworkIntegrationAwsGovCloudCTDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AwsUsGovCtSqsCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,8 +67,10 @@ func resourceLaceworkIntegrationAwsGovCloudCT() *schema.Resource {
 							Type:        schema.TypeString,
 							Required:    true,
 							Sensitive:   true,
+							WriteOnly:   true,
 							Description: "The AWS secret key for the specified AWS access key",
 						},
+						"secret_access_key_version": secretVersionSchema("secret_access_key"),
 					},
 				},
 			},
@@ -88,13 +90,15 @@ func resourceLaceworkIntegrationAwsGovCloudCT() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCTCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsUsGovCtSqsCloudAccount,
@@ -102,7 +106,7 @@ func resourceLaceworkIntegrationAwsGovCloudCTCreate(d *schema.ResourceData, meta
 				QueueUrl: d.Get("queue_url").(string),
 				Credentials: api.AwsUsGovCtSqsCredentials{
 					AccessKeyID:     d.Get("credentials.0.access_key_id").(string),
-					SecretAccessKey: d.Get("credentials.0.secret_access_key").(string),
+					SecretAccessKey: getWriteOnlyString(d, "credentials.0.secret_access_key"),
 					AwsAccountID:    d.Get("account_id").(string),
 				},
 			},
@@ -144,6 +148,7 @@ func resourceLaceworkIntegrationAwsGovCloudCTCreate(d *schema.ResourceData, meta
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Created %s integration with guid: %v\n",
 			api.AwsUsGovCtSqsCloudAccount.String(), integration.IntgGuid)
@@ -152,7 +157,7 @@ func resourceLaceworkIntegrationAwsGovCloudCTCreate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCTRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AwsUsGovCtSqsCloudAccount.String(), d.Id())
@@ -170,14 +175,15 @@ func resourceLaceworkIntegrationAwsGovCloudCTRead(d *schema.ResourceData, meta i
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 		d.Set("queue_url", integration.Data.QueueUrl)
 		d.Set("account_id", integration.Data.Credentials.AwsAccountID)
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		credentials := integration.Data.Credentials
 		creds["access_key_id"] = credentials.AccessKeyID
-		creds["secret_access_key"] = credentials.SecretAccessKey
-		d.Set("credentials", []map[string]string{creds})
+		creds["secret_access_key_version"] = d.Get("credentials.0.secret_access_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 
 		log.Printf("[INFO] Read %s integration with guid: %v\n",
 			api.AwsUsGovCtSqsCloudAccount.String(), integration.IntgGuid)
@@ -190,14 +196,14 @@ func resourceLaceworkIntegrationAwsGovCloudCTRead(d *schema.ResourceData, meta i
 
 func resourceLaceworkIntegrationAwsGovCloudCTUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		aws      = api.NewCloudAccount(d.Get("name").(string),
 			api.AwsUsGovCtSqsCloudAccount,
 			api.AwsUsGovCtSqsData{
 				QueueUrl: d.Get("queue_url").(string),
 				Credentials: api.AwsUsGovCtSqsCredentials{
 					AccessKeyID:     d.Get("credentials.0.access_key_id").(string),
-					SecretAccessKey: d.Get("credentials.0.secret_access_key").(string),
+					SecretAccessKey: getWriteOnlyString(d, "credentials.0.secret_access_key"),
 					AwsAccountID:    d.Get("account_id").(string),
 				},
 			},
@@ -225,6 +231,7 @@ func resourceLaceworkIntegrationAwsGovCloudCTUpdate(d *schema.ResourceData, meta
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Updated %s integration with guid: %v\n",
 		api.AwsUsGovCtSqsCloudAccount.String(), d.Id())
@@ -232,7 +239,7 @@ func resourceLaceworkIntegrationAwsGovCloudCTUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationAwsGovCloudCTDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AwsUsGovCtSqsCloudAccount.String(), d.Id())
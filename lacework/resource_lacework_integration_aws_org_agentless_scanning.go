@@ -15,12 +15,12 @@ import (
 
 func resourceLaceworkIntegrationAwsOrgAgentlessScanning() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceLaceworkIntegrationAwsOrgAgentlessScanningCreate,
+		Create:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsOrgAgentlessScanningCreate),
 		Read:     resourceLaceworkIntegrationAwsOrgAgentlessScanningRead,
-		Update:   resourceLaceworkIntegrationAwsOrgAgentlessScanningUpdate,
+		Update:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationAwsOrgAgentlessScanningUpdate),
 		Delete:   resourceLaceworkIntegrationAwsOrgAgentlessScanningDelete,
 		Schema:   awsOrgAgentlessScanningIntegrationSchema,
-		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Importer: &schema.ResourceImporter{StateContext: importLaceworkCloudAccount(api.AwsSidekickOrgCloudAccount.String())},
 	}
 }
 
@@ -190,11 +190,13 @@ var awsOrgAgentlessScanningIntegrationSchema = map[string]*schema.Schema{
 			},
 		},
 	},
+	"state":            integrationStateSchema(),
+	"wait_for_healthy": integrationWaitForHealthySchema(),
 }
 
 func resourceLaceworkIntegrationAwsOrgAgentlessScanningCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 	)
 
@@ -268,6 +270,7 @@ func resourceLaceworkIntegrationAwsOrgAgentlessScanningCreate(d *schema.Resource
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 		d.Set("server_token", cloudAccount.ServerToken)
 		d.Set("uri", cloudAccount.Uri)
 
@@ -278,7 +281,7 @@ func resourceLaceworkIntegrationAwsOrgAgentlessScanningCreate(d *schema.Resource
 }
 
 func resourceLaceworkIntegrationAwsOrgAgentlessScanningRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.AwsSidekickOrgCloudAccount.String(), d.Id())
 	response, err := lacework.V2.CloudAccounts.GetAwsSidekickOrg(d.Id())
@@ -295,6 +298,7 @@ func resourceLaceworkIntegrationAwsOrgAgentlessScanningRead(d *schema.ResourceDa
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		creds := make(map[string]string)
 		creds["role_arn"] = response.Data.Data.CrossAccountCreds.RoleArn
@@ -335,7 +339,7 @@ func resourceLaceworkIntegrationAwsOrgAgentlessScanningRead(d *schema.ResourceDa
 
 func resourceLaceworkIntegrationAwsOrgAgentlessScanningUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	awsOrgAgentlessScanningData := api.AwsSidekickOrgData{
@@ -393,13 +397,14 @@ func resourceLaceworkIntegrationAwsOrgAgentlessScanningUpdate(d *schema.Resource
 	d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 	d.Set("type_name", cloudAccount.Type)
 	d.Set("org_level", cloudAccount.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 	log.Printf("[INFO] Updated %s cloud account integration with guid: %v\n", api.AwsSidekickOrgCloudAccount.String(), d.Id())
 	return nil
 }
 
 func resourceLaceworkIntegrationAwsOrgAgentlessScanningDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.AwsSidekickOrgCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
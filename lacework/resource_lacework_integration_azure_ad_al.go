@@ -13,13 +13,13 @@ import (
 
 func resourceLaceworkIntegrationAzureAdAl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationAzureAdAlCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAzureAdAlCreate),
 		Read:   resourceLaceworkIntegrationAzureAdAlRead,
-		Update: resourceLaceworkIntegrationAzureAdAlUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAzureAdAlUpdate),
 		Delete: resourceLaceworkIntegrationAzureAdAlDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AzureAdAlCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,16 +68,9 @@ func resourceLaceworkIntegrationAzureAdAl() *schema.Resource {
 							Type:      schema.TypeString,
 							Required:  true,
 							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// @afiune we can't compare this element since our API, for security reasons,
-								// does NOT return the client secret configured in the Lacework server. So if
-								// any other element changed from the credentials then we trigger a diff
-								return !d.HasChanges(
-									"name", "tenant_id", "org_level", "event_hub_namespace",
-									"event_hub_name", "enabled", "credentials.0.client_id",
-								)
-							},
+							WriteOnly: true,
 						},
+						"client_secret_version": secretVersionSchema("client_secret"),
 					},
 				},
 			},
@@ -97,13 +90,15 @@ func resourceLaceworkIntegrationAzureAdAl() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationAzureAdAlCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		azure    = api.NewCloudAccount(d.Get("name").(string),
 			api.AzureAdAlCloudAccount,
@@ -113,7 +108,7 @@ func resourceLaceworkIntegrationAzureAdAlCreate(d *schema.ResourceData, meta int
 				EventHubName:      d.Get("event_hub_name").(string),
 				Credentials: api.AzureAdAlCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
-					ClientSecret: d.Get("credentials.0.client_secret").(string),
+					ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 				},
 			},
 		)
@@ -152,6 +147,7 @@ func resourceLaceworkIntegrationAzureAdAlCreate(d *schema.ResourceData, meta int
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Created %s integration with guid: %v\n",
 			api.AzureAdAlCloudAccount.String(), integration.IntgGuid)
@@ -160,7 +156,7 @@ func resourceLaceworkIntegrationAzureAdAlCreate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkIntegrationAzureAdAlRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n", api.AzureAdAlCloudAccount.String(), d.Id())
 	response, err := lacework.V2.CloudAccounts.GetAzureAdAl(d.Id())
@@ -177,10 +173,12 @@ func resourceLaceworkIntegrationAzureAdAlRead(d *schema.ResourceData, meta inter
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = integration.Data.Credentials.ClientID
-		d.Set("credentials", []map[string]string{creds})
+		creds["client_secret_version"] = d.Get("credentials.0.client_secret_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("event_hub_namespace", integration.Data.EventHubNamespace)
 		d.Set("event_hub_name", integration.Data.EventHubName)
 		d.Set("tenant_id", integration.Data.TenantID)
@@ -195,7 +193,7 @@ func resourceLaceworkIntegrationAzureAdAlRead(d *schema.ResourceData, meta inter
 
 func resourceLaceworkIntegrationAzureAdAlUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		azure    = api.NewCloudAccount(d.Get("name").(string),
 			api.AzureAdAlCloudAccount,
 			api.AzureAdAlData{
@@ -204,7 +202,7 @@ func resourceLaceworkIntegrationAzureAdAlUpdate(d *schema.ResourceData, meta int
 				EventHubName:      d.Get("event_hub_name").(string),
 				Credentials: api.AzureAdAlCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
-					ClientSecret: d.Get("credentials.0.client_secret").(string),
+					ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 				},
 			},
 		)
@@ -230,13 +228,14 @@ func resourceLaceworkIntegrationAzureAdAlUpdate(d *schema.ResourceData, meta int
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Updated %sw integration with guid: %v\n", api.AzureAdAlCloudAccount.String(), d.Id())
 	return nil
 }
 
 func resourceLaceworkIntegrationAzureAdAlDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n", api.AzureAdAlCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -14,13 +14,13 @@ import (
 
 func resourceLaceworkIntegrationAzureAgentlessScanning() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationAzureAgentlessScanningCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAzureAgentlessScanningCreate),
 		Read:   resourceLaceworkIntegrationAzureAgentlessScanningRead,
-		Update: resourceLaceworkIntegrationAzureAgentlessScanningUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAzureAgentlessScanningUpdate),
 		Delete: resourceLaceworkIntegrationAzureAgentlessScanningDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AzureSidekickCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,23 +57,13 @@ func resourceLaceworkIntegrationAzureAgentlessScanning() *schema.Resource {
 							Description: "Client Id from credentials file.",
 						},
 						"client_secret": {
-							Type:     schema.TypeString,
-							Required: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// we don't compare this field for security reasons. so here only compare other fields
-								return !d.HasChanges(
-									"name",
-									"integration_level",
-									"tenant_id",
-									"scanning_subscription_id",
-									"scanning_resource_group_name",
-									"storage_account_url",
-									"enabled",
-									"credentials.0.client_id",
-								)
-							},
+							Type:        schema.TypeString,
+							Sensitive:   true,
+							WriteOnly:   true,
+							Required:    true,
 							Description: "Client secret from credentials file.",
 						},
+						"client_secret_version": secretVersionSchema("client_secret"),
 					},
 				},
 			},
@@ -189,13 +179,15 @@ func resourceLaceworkIntegrationAzureAgentlessScanning() *schema.Resource {
 				Default:     nil,
 				Description: "List of subscriptions to specifically include/exclude.",
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationAzureAgentlessScanningCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework         = meta.(*api.Client)
+		lacework         = meta.(*providerMeta).client
 		retries          = d.Get("retries").(int)
 		integrationLevel = api.AzureSubscriptionIntegration
 	)
@@ -213,7 +205,7 @@ func resourceLaceworkIntegrationAzureAgentlessScanningCreate(d *schema.ResourceD
 			IntegrationLevel: integrationLevel,
 			Credentials: api.AzureSidekickCredentials{
 				ClientId:     d.Get("credentials.0.client_id").(string),
-				ClientSecret: d.Get("credentials.0.client_secret").(string),
+				ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 			},
 			BlobContainerName:         d.Get("blob_container_name").(string),
 			ScanningResourceGroupName: d.Get("scanning_resource_group_name").(string),
@@ -264,6 +256,7 @@ func resourceLaceworkIntegrationAzureAgentlessScanningCreate(d *schema.ResourceD
 		d.Set("enabled", integration.Enabled == 1)
 		d.Set("created_or_updated_time", integration.CreatedOrUpdatedTime)
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
+		d.Set("state", flattenIntegrationState(integration.State))
 		d.Set("server_token", integration.ServerToken)
 		d.Set("uri", integration.Uri)
 
@@ -274,7 +267,7 @@ func resourceLaceworkIntegrationAzureAgentlessScanningCreate(d *schema.ResourceD
 }
 
 func resourceLaceworkIntegrationAzureAgentlessScanningRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AzureSidekickCloudAccount.String(), d.Id())
@@ -290,11 +283,13 @@ func resourceLaceworkIntegrationAzureAgentlessScanningRead(d *schema.ResourceDat
 		d.Set("enabled", integration.Enabled == 1)
 		d.Set("created_or_updated_time", integration.CreatedOrUpdatedTime)
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
+		d.Set("state", flattenIntegrationState(integration.State))
 		d.Set("integration_level", integration.Type)
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = integration.Data.Credentials.ClientId
-		d.Set("credentials", []map[string]string{creds})
+		creds["client_secret_version"] = d.Get("credentials.0.client_secret_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 
 		d.Set("integration_level", integration.Data.IntegrationLevel)
 		d.Set("scanning_subscription_id", integration.Data.ScanningSubscriptionId)
@@ -327,7 +322,7 @@ func resourceLaceworkIntegrationAzureAgentlessScanningRead(d *schema.ResourceDat
 
 func resourceLaceworkIntegrationAzureAgentlessScanningUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework         = meta.(*api.Client)
+		lacework         = meta.(*providerMeta).client
 		integrationLevel = api.AzureSubscriptionIntegration
 	)
 
@@ -343,7 +338,7 @@ func resourceLaceworkIntegrationAzureAgentlessScanningUpdate(d *schema.ResourceD
 			IntegrationLevel:       integrationLevel,
 			Credentials: api.AzureSidekickCredentials{
 				ClientId:     d.Get("credentials.0.client_id").(string),
-				ClientSecret: d.Get("credentials.0.client_secret").(string),
+				ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 			},
 			BlobContainerName:         d.Get("blob_container_name").(string),
 			ScanningResourceGroupName: d.Get("scanning_resource_group_name").(string),
@@ -383,6 +378,7 @@ func resourceLaceworkIntegrationAzureAgentlessScanningUpdate(d *schema.ResourceD
 	d.Set("storage_account_url", integration.Data.StorageAccountUrl)
 	d.Set("created_or_updated_time", integration.CreatedOrUpdatedTime)
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
+	d.Set("state", flattenIntegrationState(integration.State))
 	d.Set("server_token", integration.ServerToken)
 	d.Set("uri", integration.Uri)
 	log.Printf("[INFO] Updated %s integration with guid: %v\n",
@@ -391,7 +387,7 @@ func resourceLaceworkIntegrationAzureAgentlessScanningUpdate(d *schema.ResourceD
 }
 
 func resourceLaceworkIntegrationAzureAgentlessScanningDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AzureSidekickCloudAccount.String(), d.Id())
@@ -13,13 +13,13 @@ import (
 
 func resourceLaceworkIntegrationAzureActivityLog() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationAzureActivityLogCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAzureActivityLogCreate),
 		Read:   resourceLaceworkIntegrationAzureActivityLogRead,
-		Update: resourceLaceworkIntegrationAzureActivityLogUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAzureActivityLogUpdate),
 		Delete: resourceLaceworkIntegrationAzureActivityLogDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AzureAlSeqCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,16 +64,9 @@ func resourceLaceworkIntegrationAzureActivityLog() *schema.Resource {
 							Type:      schema.TypeString,
 							Required:  true,
 							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// @afiune we can't compare this element since our API, for security reasons,
-								// does NOT return the client secret configured in the Lacework server. So if
-								// any other element changed from the credentials then we trigger a diff
-								return !d.HasChanges(
-									"name", "tenant_id", "org_level", "queue_url",
-									"enabled", "credentials.0.client_id",
-								)
-							},
+							WriteOnly: true,
 						},
+						"client_secret_version": secretVersionSchema("client_secret"),
 					},
 				},
 			},
@@ -93,13 +86,15 @@ func resourceLaceworkIntegrationAzureActivityLog() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationAzureActivityLogCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		azure    = api.NewCloudAccount(d.Get("name").(string),
 			api.AzureAlSeqCloudAccount,
@@ -108,7 +103,7 @@ func resourceLaceworkIntegrationAzureActivityLogCreate(d *schema.ResourceData, m
 				QueueUrl: d.Get("queue_url").(string),
 				Credentials: api.AzureAlSeqCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
-					ClientSecret: d.Get("credentials.0.client_secret").(string),
+					ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 				},
 			},
 		)
@@ -147,6 +142,7 @@ func resourceLaceworkIntegrationAzureActivityLogCreate(d *schema.ResourceData, m
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Created %s integration with guid: %v\n",
 			api.AzureAlSeqCloudAccount.String(), integration.IntgGuid)
@@ -155,7 +151,7 @@ func resourceLaceworkIntegrationAzureActivityLogCreate(d *schema.ResourceData, m
 }
 
 func resourceLaceworkIntegrationAzureActivityLogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n", api.AzureAlSeqCloudAccount.String(), d.Id())
 	response, err := lacework.V2.CloudAccounts.GetAzureAlSeq(d.Id())
@@ -172,10 +168,12 @@ func resourceLaceworkIntegrationAzureActivityLogRead(d *schema.ResourceData, met
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = integration.Data.Credentials.ClientID
-		d.Set("credentials", []map[string]string{creds})
+		creds["client_secret_version"] = d.Get("credentials.0.client_secret_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("queue_url", integration.Data.QueueUrl)
 		d.Set("tenant_id", integration.Data.TenantID)
 
@@ -189,7 +187,7 @@ func resourceLaceworkIntegrationAzureActivityLogRead(d *schema.ResourceData, met
 
 func resourceLaceworkIntegrationAzureActivityLogUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		azure    = api.NewCloudAccount(d.Get("name").(string),
 			api.AzureAlSeqCloudAccount,
 			api.AzureAlSeqData{
@@ -197,7 +195,7 @@ func resourceLaceworkIntegrationAzureActivityLogUpdate(d *schema.ResourceData, m
 				QueueUrl: d.Get("queue_url").(string),
 				Credentials: api.AzureAlSeqCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
-					ClientSecret: d.Get("credentials.0.client_secret").(string),
+					ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 				},
 			},
 		)
@@ -223,13 +221,14 @@ func resourceLaceworkIntegrationAzureActivityLogUpdate(d *schema.ResourceData, m
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Updated %sw integration with guid: %v\n", api.AzureAlSeqCloudAccount.String(), d.Id())
 	return nil
 }
 
 func resourceLaceworkIntegrationAzureActivityLogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n", api.AzureAlSeqCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -13,13 +13,13 @@ import (
 
 func resourceLaceworkIntegrationAzureCfg() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationAzureCfgCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAzureCfgCreate),
 		Read:   resourceLaceworkIntegrationAzureCfgRead,
-		Update: resourceLaceworkIntegrationAzureCfgUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationAzureCfgUpdate),
 		Delete: resourceLaceworkIntegrationAzureCfgDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.AzureCfgCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,16 +60,9 @@ func resourceLaceworkIntegrationAzureCfg() *schema.Resource {
 							Type:      schema.TypeString,
 							Required:  true,
 							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// @afiune we can't compare this element since our API, for security reasons,
-								// does NOT return the client secret configured in the Lacework server. So if
-								// any other element changed from the credentials then we trigger a diff
-								return !d.HasChanges(
-									"name", "tenant_id", "org_level",
-									"enabled", "credentials.0.client_id",
-								)
-							},
+							WriteOnly: true,
 						},
+						"client_secret_version": secretVersionSchema("client_secret"),
 					},
 				},
 			},
@@ -89,13 +82,15 @@ func resourceLaceworkIntegrationAzureCfg() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationAzureCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		azure    = api.NewCloudAccount(d.Get("name").(string),
 			api.AzureCfgCloudAccount,
@@ -103,7 +98,7 @@ func resourceLaceworkIntegrationAzureCfgCreate(d *schema.ResourceData, meta inte
 				TenantID: d.Get("tenant_id").(string),
 				Credentials: api.AzureCfgCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
-					ClientSecret: d.Get("credentials.0.client_secret").(string),
+					ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 				},
 			},
 		)
@@ -143,6 +138,7 @@ func resourceLaceworkIntegrationAzureCfgCreate(d *schema.ResourceData, meta inte
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Created %s integration with guid: %v\n",
 			api.AzureCfgCloudAccount.String(), integration.IntgGuid)
@@ -151,7 +147,7 @@ func resourceLaceworkIntegrationAzureCfgCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkIntegrationAzureCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.AzureCfgCloudAccount.String(), d.Id())
@@ -169,10 +165,12 @@ func resourceLaceworkIntegrationAzureCfgRead(d *schema.ResourceData, meta interf
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = integration.Data.Credentials.ClientID
-		d.Set("credentials", []map[string]string{creds})
+		creds["client_secret_version"] = d.Get("credentials.0.client_secret_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("tenant_id", integration.Data.TenantID)
 
 		log.Printf("[INFO] Read %s integration with guid: %v\n", api.AzureCfgCloudAccount.String(), integration.IntgGuid)
@@ -185,14 +183,14 @@ func resourceLaceworkIntegrationAzureCfgRead(d *schema.ResourceData, meta interf
 
 func resourceLaceworkIntegrationAzureCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		azure    = api.NewCloudAccount(d.Get("name").(string),
 			api.AzureCfgCloudAccount,
 			api.AzureCfgData{
 				TenantID: d.Get("tenant_id").(string),
 				Credentials: api.AzureCfgCredentials{
 					ClientID:     d.Get("credentials.0.client_id").(string),
-					ClientSecret: d.Get("credentials.0.client_secret").(string),
+					ClientSecret: getWriteOnlyString(d, "credentials.0.client_secret"),
 				},
 			},
 		)
@@ -219,6 +217,7 @@ func resourceLaceworkIntegrationAzureCfgUpdate(d *schema.ResourceData, meta inte
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Updated %s integration with guid: %v\n",
 		api.AzureCfgCloudAccount.String(), d.Id())
@@ -226,7 +225,7 @@ func resourceLaceworkIntegrationAzureCfgUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkIntegrationAzureCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.AzureCfgCloudAccount.String(), d.Id())
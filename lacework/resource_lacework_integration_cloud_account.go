@@ -0,0 +1,355 @@
+package lacework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// cloudAccountSensitiveKeyPatterns are the patterns of the keys that the Lacework API
+// never returns, or returns redacted, the configured values of these keys are kept
+var cloudAccountSensitiveKeyPatterns = []string{"secret", "password", "privatekey", "token"}
+
+func resourceLaceworkIntegrationCloudAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationCloudAccountCreate),
+		Read:   resourceLaceworkIntegrationCloudAccountRead,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationCloudAccountUpdate),
+		Delete: resourceLaceworkIntegrationCloudAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importLaceworkCloudAccount(),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "The cloud account type (i.e. AwsCfg, GcpCfg, OciCfg), types unknown to this " +
+					"version of the provider are sent to the Lacework API as is",
+				ValidateDiagFunc: validateCloudAccountType,
+			},
+			"data": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "The JSON document with the data of the cloud account integration",
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"sensitive_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "The keys of the data document that the Lacework API doesn't return, " +
+					"their configured values are kept instead of being compared with the API response",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"intg_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "The number of attempts to create the external integration.",
+			},
+			"created_or_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_or_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"org_level": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
+		},
+	}
+}
+
+func resourceLaceworkIntegrationCloudAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework = meta.(*providerMeta).client
+		retries  = d.Get("retries").(int)
+	)
+
+	data, err := getCloudAccountRaw(d)
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryContext(context.Background(), d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		retries--
+		log.Printf("[INFO] Creating %s integration\n", data.Type)
+		response, err := lacework.V2.CloudAccounts.Create(data)
+		if err != nil {
+			if retries <= 0 {
+				return retry.NonRetryableError(
+					fmt.Errorf("Error creating %s integration: %s", data.Type, err),
+				)
+			}
+			log.Printf(
+				"[INFO] Unable to create %s integration. (retrying %d more time(s))\n%s\n",
+				data.Type, retries, err,
+			)
+			return retry.RetryableError(fmt.Errorf(
+				"Unable to create %s integration (retrying %d more time(s))",
+				data.Type, retries,
+			))
+		}
+
+		integration := response.Data
+		d.SetId(integration.IntgGuid)
+		d.Set("name", integration.Name)
+		d.Set("intg_guid", integration.IntgGuid)
+		d.Set("enabled", integration.Enabled == 1)
+		d.Set("created_or_updated_time", integration.CreatedOrUpdatedTime)
+		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
+		d.Set("type_name", integration.Type)
+		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
+
+		log.Printf("[INFO] Created %s integration with guid: %v\n", data.Type, integration.IntgGuid)
+		return nil
+	})
+}
+
+func resourceLaceworkIntegrationCloudAccountRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Reading cloud account integration with guid: %v\n", d.Id())
+	var response api.CloudAccountResponse
+	if err := lacework.V2.CloudAccounts.Get(d.Id(), &response); err != nil {
+		return resourceNotFound(d, err)
+	}
+
+	integration := response.Data
+	if integration.IntgGuid != d.Id() {
+		d.SetId("")
+		return nil
+	}
+
+	data, err := normalizeCloudAccountData(
+		d.Get("data").(string),
+		integration.Data,
+		castStringSlice(d.Get("sensitive_keys").(*schema.Set).List()),
+	)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", integration.Name)
+	d.Set("type", integration.Type)
+	d.Set("data", data)
+	d.Set("intg_guid", integration.IntgGuid)
+	d.Set("enabled", integration.Enabled == 1)
+	d.Set("created_or_updated_time", integration.CreatedOrUpdatedTime)
+	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
+	d.Set("type_name", integration.Type)
+	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
+
+	log.Printf("[INFO] Read %s integration with guid: %v\n", integration.Type, integration.IntgGuid)
+	return nil
+}
+
+func resourceLaceworkIntegrationCloudAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	data, err := getCloudAccountRaw(d)
+	if err != nil {
+		return err
+	}
+	data.IntgGuid = d.Id()
+
+	// the go-sdk only exposes typed updates, a raw update is required
+	// to support the cloud account types unknown to the go-sdk
+	log.Printf("[INFO] Updating %s integration with guid: %v\n", data.Type, d.Id())
+	var response api.CloudAccountResponse
+	err = lacework.RequestEncoderDecoder("PATCH", fmt.Sprintf("v2/CloudAccounts/%s", d.Id()), data, &response)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updated %s integration with guid: %v\n", data.Type, d.Id())
+	return resourceLaceworkIntegrationCloudAccountRead(d, meta)
+}
+
+func resourceLaceworkIntegrationCloudAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Deleting cloud account integration with guid: %v\n", d.Id())
+	err := lacework.V2.CloudAccounts.Delete(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleted cloud account integration with guid: %v\n", d.Id())
+	return nil
+}
+
+// validateCloudAccountType warns about cloud account types that are unknown to
+// the go-sdk, they are still allowed to support types newer than the provider
+func validateCloudAccountType(value interface{}, path cty.Path) diag.Diagnostics {
+	cloudAccountType := value.(string)
+	if strings.TrimSpace(cloudAccountType) == "" {
+		return diag.Errorf("type must not be empty")
+	}
+
+	if _, found := api.FindCloudAccountType(cloudAccountType); !found {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("unknown cloud account type '%s'", cloudAccountType),
+			Detail: fmt.Sprintf("The cloud account type '%s' is unknown to this version of the provider, "+
+				"it is sent to the Lacework API as is. Known types are: %s",
+				cloudAccountType, strings.Join(cloudAccountTypeNames(), ", ")),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+// cloudAccountTypeNames returns the sorted names of the cloud account types known to the go-sdk
+func cloudAccountTypeNames() []string {
+	names := make([]string, 0, len(api.CloudAccountTypes))
+	for cloudAccountType, name := range api.CloudAccountTypes {
+		if cloudAccountType == api.NoneCloudAccount {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func getCloudAccountRaw(d *schema.ResourceData) (api.CloudAccountRaw, error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("data").(string)), &data); err != nil {
+		return api.CloudAccountRaw{}, fmt.Errorf("unable to decode data: %s", err)
+	}
+
+	cloudAccountType, found := api.FindCloudAccountType(d.Get("type").(string))
+	raw := api.NewCloudAccount(d.Get("name").(string), cloudAccountType, data)
+	if !found {
+		raw.Type = d.Get("type").(string)
+	}
+
+	if !d.Get("enabled").(bool) {
+		raw.Enabled = 0
+	}
+	return raw, nil
+}
+
+// normalizeCloudAccountData returns the data document read from the Lacework API in
+// the shape of the configured document. The keys that are not configured are ignored,
+// and the sensitive keys or the keys that the API omits or redacts keep their configured
+// values, otherwise every read would report a difference. When no document is
+// configured, like during an import, the document read from the API is returned.
+func normalizeCloudAccountData(configured string, remote interface{}, sensitiveKeys []string) (string, error) {
+	remoteMap, _ := remote.(map[string]interface{})
+
+	if configured == "" {
+		return marshalCloudAccountData(remoteMap)
+	}
+
+	var configuredMap map[string]interface{}
+	if err := json.Unmarshal([]byte(configured), &configuredMap); err != nil {
+		return "", fmt.Errorf("unable to decode data: %s", err)
+	}
+
+	return marshalCloudAccountData(mergeCloudAccountData(configuredMap, remoteMap, sensitiveKeys))
+}
+
+func mergeCloudAccountData(configured, remote map[string]interface{}, sensitiveKeys []string) map[string]interface{} {
+	merged := make(map[string]interface{}, len(configured))
+	for key, value := range configured {
+		remoteValue, found := remote[key]
+		if !found || isCloudAccountSensitiveKey(key, sensitiveKeys) || isRedactedCloudAccountValue(remoteValue) {
+			merged[key] = value
+			continue
+		}
+
+		configuredChild, configuredIsMap := value.(map[string]interface{})
+		remoteChild, remoteIsMap := remoteValue.(map[string]interface{})
+		if configuredIsMap && remoteIsMap {
+			merged[key] = mergeCloudAccountData(configuredChild, remoteChild, sensitiveKeys)
+			continue
+		}
+
+		merged[key] = remoteValue
+	}
+	return merged
+}
+
+func isCloudAccountSensitiveKey(key string, sensitiveKeys []string) bool {
+	if ContainsStr(sensitiveKeys, key) {
+		return true
+	}
+
+	lowerKey := strings.ToLower(key)
+	for _, pattern := range cloudAccountSensitiveKeyPatterns {
+		if strings.Contains(lowerKey, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRedactedCloudAccountValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.Trim(v, "*") == ""
+	default:
+		return false
+	}
+}
+
+func marshalCloudAccountData(data map[string]interface{}) (string, error) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode data: %s", err)
+	}
+	return string(bytes), nil
+}
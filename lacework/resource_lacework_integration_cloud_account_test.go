@@ -0,0 +1,55 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCloudAccountData(t *testing.T) {
+	remote := map[string]interface{}{
+		"id":     "my-project",
+		"idType": "PROJECT",
+		"credentials": map[string]interface{}{
+			"clientId":     "123",
+			"clientEmail":  "new@example.com",
+			"privateKeyId": "abc",
+			"privateKey":   "****",
+		},
+		"serverDefault": true,
+	}
+
+	configured := `{"id":"my-project","idType":"PROJECT","credentials":{` +
+		`"clientId":"123","clientEmail":"old@example.com","privateKeyId":"abc","privateKey":"KEY"}}`
+
+	data, err := normalizeCloudAccountData(configured, remote, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"credentials":{"clientEmail":"new@example.com","clientId":"123",`+
+			`"privateKey":"KEY","privateKeyId":"abc"},"id":"my-project","idType":"PROJECT"}`, data)
+	}
+
+	// configured sensitive keys are never compared with the response
+	data, err = normalizeCloudAccountData(configured, remote, []string{"clientEmail"})
+	if assert.NoError(t, err) {
+		assert.Contains(t, data, `"clientEmail":"old@example.com"`)
+	}
+
+	// without a configured document, like during an import, the response is returned
+	data, err = normalizeCloudAccountData("", map[string]interface{}{"id": "my-project"}, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"id":"my-project"}`, data)
+	}
+
+	_, err = normalizeCloudAccountData("{", remote, nil)
+	assert.Error(t, err)
+}
+
+func TestValidateCloudAccountType(t *testing.T) {
+	assert.Empty(t, validateCloudAccountType("GcpCfg", nil))
+	assert.True(t, validateCloudAccountType("", nil).HasError())
+
+	diags := validateCloudAccountType("FutureCloudCfg", nil)
+	if assert.Len(t, diags, 1) {
+		assert.False(t, diags.HasError())
+	}
+}
@@ -11,13 +11,13 @@ import (
 
 func resourceLaceworkIntegrationDockerHub() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationDockerHubCreate,
+		Create: containerRegistryWaitForHealthy(resourceLaceworkIntegrationDockerHubCreate),
 		Read:   resourceLaceworkIntegrationDockerHubRead,
-		Update: resourceLaceworkIntegrationDockerHubUpdate,
+		Update: containerRegistryWaitForHealthy(resourceLaceworkIntegrationDockerHubUpdate),
 		Delete: resourceLaceworkIntegrationDockerHubDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkContainerRegistry(api.DockerhubContainerRegistry.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,8 +41,10 @@ func resourceLaceworkIntegrationDockerHub() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
 				Description: "The password for the specified Docker Hub user",
 			},
+			"password_version": secretVersionSchema("password"),
 			"limit_by_tags": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -115,12 +117,14 @@ func resourceLaceworkIntegrationDockerHub() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationDockerHubCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.DockerhubContainerRegistry,
 		api.DockerhubData{
@@ -131,7 +135,7 @@ func resourceLaceworkIntegrationDockerHubCreate(d *schema.ResourceData, meta int
 			NonOSPackageEval: d.Get("non_os_package_support").(bool),
 			Credentials: api.DockerhubCredentials{
 				Username: d.Get("username").(string),
-				Password: d.Get("password").(string),
+				Password: getWriteOnlyString(d, "password"),
 			},
 		},
 	)
@@ -155,13 +159,14 @@ func resourceLaceworkIntegrationDockerHubCreate(d *schema.ResourceData, meta int
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Created %s registry type with guid: %v\n", api.DockerhubContainerRegistry.String(), integration.IntgGuid)
 	return nil
 }
 
 func resourceLaceworkIntegrationDockerHubRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s registry type with guid: %v\n", api.DockerhubContainerRegistry.String(), d.Id())
 	response, err := lacework.V2.ContainerRegistries.GetDockerhub(d.Id())
@@ -179,6 +184,7 @@ func resourceLaceworkIntegrationDockerHubRead(d *schema.ResourceData, meta inter
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		d.Set("username", integration.Data.Credentials.Username)
 		d.Set("non_os_package_support", integration.Data.NonOSPackageEval)
@@ -198,7 +204,7 @@ func resourceLaceworkIntegrationDockerHubRead(d *schema.ResourceData, meta inter
 }
 
 func resourceLaceworkIntegrationDockerHubUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.DockerhubContainerRegistry,
 		api.DockerhubData{
@@ -209,7 +215,7 @@ func resourceLaceworkIntegrationDockerHubUpdate(d *schema.ResourceData, meta int
 			NonOSPackageEval: d.Get("non_os_package_support").(bool),
 			Credentials: api.DockerhubCredentials{
 				Username: d.Get("username").(string),
-				Password: d.Get("password").(string),
+				Password: getWriteOnlyString(d, "password"),
 			},
 		},
 	)
@@ -235,6 +241,7 @@ func resourceLaceworkIntegrationDockerHubUpdate(d *schema.ResourceData, meta int
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Updated %s registry type with guid: %v\n", api.DockerhubContainerRegistry.String(), d.Id())
 		return nil
@@ -244,7 +251,7 @@ func resourceLaceworkIntegrationDockerHubUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceLaceworkIntegrationDockerHubDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s registry type with guid: %v\n", api.DockerhubContainerRegistry.String(), d.Id())
 
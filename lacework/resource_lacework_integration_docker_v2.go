@@ -11,13 +11,13 @@ import (
 
 func resourceLaceworkIntegrationDockerV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationDockerV2Create,
+		Create: containerRegistryWaitForHealthy(resourceLaceworkIntegrationDockerV2Create),
 		Read:   resourceLaceworkIntegrationDockerV2Read,
-		Update: resourceLaceworkIntegrationDockerV2Update,
+		Update: containerRegistryWaitForHealthy(resourceLaceworkIntegrationDockerV2Update),
 		Delete: resourceLaceworkIntegrationDockerV2Delete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkContainerRegistry(api.DockerhubV2ContainerRegistry.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,7 +42,9 @@ func resourceLaceworkIntegrationDockerV2() *schema.Resource {
 				Type:      schema.TypeString,
 				Required:  true,
 				Sensitive: true,
+				WriteOnly: true,
 			},
+			"password_version": secretVersionSchema("password"),
 			"ssl": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -109,12 +111,14 @@ func resourceLaceworkIntegrationDockerV2() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationDockerV2Create(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	notifications := d.Get("notifications").(bool)
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.DockerhubV2ContainerRegistry,
@@ -126,7 +130,7 @@ func resourceLaceworkIntegrationDockerV2Create(d *schema.ResourceData, meta inte
 			RegistryNotifications: &notifications,
 			Credentials: api.DockerhubV2Credentials{
 				Username: d.Get("username").(string),
-				Password: d.Get("password").(string),
+				Password: getWriteOnlyString(d, "password"),
 				SSL:      d.Get("ssl").(bool),
 			},
 		},
@@ -151,13 +155,14 @@ func resourceLaceworkIntegrationDockerV2Create(d *schema.ResourceData, meta inte
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Created %s registry type with guid: %v\n", api.DockerhubV2ContainerRegistry.String(), integration.IntgGuid)
 	return nil
 }
 
 func resourceLaceworkIntegrationDockerV2Read(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s registry type with guid: %v\n", api.DockerhubV2ContainerRegistry.String(), d.Id())
 	response, err := lacework.V2.ContainerRegistries.GetDockerhubV2(d.Id())
@@ -175,10 +180,10 @@ func resourceLaceworkIntegrationDockerV2Read(d *schema.ResourceData, meta interf
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		d.Set("registry_domain", integration.Data.RegistryDomain)
 		d.Set("username", integration.Data.Credentials.Username)
-		d.Set("password", integration.Data.Credentials.Password)
 		d.Set("ssl", integration.Data.Credentials.SSL)
 		d.Set("non_os_package_support", integration.Data.NonOSPackageEval)
 		d.Set("notifications", integration.Data.RegistryNotifications)
@@ -196,7 +201,7 @@ func resourceLaceworkIntegrationDockerV2Read(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationDockerV2Update(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	notifications := d.Get("notifications").(bool)
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.DockerhubV2ContainerRegistry,
@@ -208,7 +213,7 @@ func resourceLaceworkIntegrationDockerV2Update(d *schema.ResourceData, meta inte
 			RegistryNotifications: &notifications,
 			Credentials: api.DockerhubV2Credentials{
 				Username: d.Get("username").(string),
-				Password: d.Get("password").(string),
+				Password: getWriteOnlyString(d, "password"),
 				SSL:      d.Get("ssl").(bool),
 			},
 		},
@@ -235,6 +240,7 @@ func resourceLaceworkIntegrationDockerV2Update(d *schema.ResourceData, meta inte
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Updated %s registry type with guid: %v\n", api.DockerhubV2ContainerRegistry.String(), d.Id())
 
@@ -245,7 +251,7 @@ func resourceLaceworkIntegrationDockerV2Update(d *schema.ResourceData, meta inte
 }
 
 func resourceLaceworkIntegrationDockerV2Delete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s registry type with guid: %v\n", api.DockerhubV2ContainerRegistry.String(), d.Id())
 
@@ -14,14 +14,20 @@ import (
 )
 
 func importLaceworkECRIntegration(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	var awsAuthType string
 
+	guid, err := resolveContainerRegistryImportID(d.Id(), lacework, []string{api.AwsEcrContainerRegistry.String()})
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(guid)
+
 	log.Printf("[INFO] Importing Lacework integration with guid: %s\n", d.Id())
 
 	var response api.ContainerRegistryRaw
 
-	err := lacework.V2.ContainerRegistries.Get(d.Id(), &response)
+	err = lacework.V2.ContainerRegistries.Get(d.Id(), &response)
 	if err != nil {
 		return nil, err
 	}
@@ -66,9 +72,9 @@ func getAuthTypeFromRaw(raw api.ContainerRegistryRaw) (string, error) {
 
 func resourceLaceworkIntegrationEcr() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationEcrCreate,
+		Create: containerRegistryWaitForHealthy(resourceLaceworkIntegrationEcrCreate),
 		Read:   resourceLaceworkIntegrationEcrRead,
-		Update: resourceLaceworkIntegrationEcrUpdate,
+		Update: containerRegistryWaitForHealthy(resourceLaceworkIntegrationEcrUpdate),
 		Delete: resourceLaceworkIntegrationEcrDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -118,8 +124,10 @@ func resourceLaceworkIntegrationEcr() *schema.Resource {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Sensitive:   true,
+							WriteOnly:   true,
 							Description: "The AWS secret key for the specified AWS access key",
 						},
+						"secret_access_key_version": secretVersionSchema("secret_access_key"),
 					},
 				},
 			},
@@ -202,12 +210,14 @@ func resourceLaceworkIntegrationEcr() *schema.Resource {
 				Computed:    true,
 				Description: "Whether or not this integration is configured at the Organization level",
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationEcrCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	switch detectAuthenticationMethod(d) {
 	case api.AwsEcrAccessKey.String():
@@ -247,7 +257,7 @@ func resourceLaceworkIntegrationEcrRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLaceworkIntegrationEcrUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	switch d.Get("aws_auth_type").(string) {
 	case api.AwsEcrAccessKey.String():
@@ -342,6 +352,7 @@ func resourceLaceworkIntegrationEcrUpdateWithIAMRole(d *schema.ResourceData, lac
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	// @afiune this field is important for updates since it will force a new resource
 	d.Set("aws_auth_type", integration.Data.AwsAuthType)
 
@@ -356,7 +367,7 @@ func resourceLaceworkIntegrationEcrUpdateWithAccessKey(d *schema.ResourceData, l
 		api.AwsEcrAccessKeyData{
 			AccessKeyCredentials: api.AwsEcrAccessKeyCredentials{
 				AccessKeyID:     d.Get("credentials.0.access_key_id").(string),
-				SecretAccessKey: d.Get("credentials.0.secret_access_key").(string),
+				SecretAccessKey: getWriteOnlyString(d, "credentials.0.secret_access_key"),
 			},
 			LimitByTag:       castAttributeToStringSlice(d, "limit_by_tags"),
 			LimitByLabel:     castAttributeToArrayOfKeyValueMap(d, "limit_by_label"),
@@ -387,6 +398,7 @@ func resourceLaceworkIntegrationEcrUpdateWithAccessKey(d *schema.ResourceData, l
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	// @afiune this field is important for updates since it will force a new resource
 	d.Set("aws_auth_type", integration.Data.AwsAuthType)
 
@@ -396,7 +408,7 @@ func resourceLaceworkIntegrationEcrUpdateWithAccessKey(d *schema.ResourceData, l
 }
 
 func resourceLaceworkIntegrationEcrDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s registry type with guid: %v\n", api.AwsEcrContainerRegistry.String(), d.Id())
 
@@ -437,7 +449,7 @@ func validateAccessKeyCreds(d *schema.ResourceData) error {
 	if d.Get("credentials.0.access_key_id").(string) == "" {
 		return errors.New("missing access_key_id argument inside credentials block.")
 	}
-	if d.Get("credentials.0.secret_access_key").(string) == "" {
+	if getWriteOnlyString(d, "credentials.0.secret_access_key") == "" {
 		return errors.New("missing secret_access_key argument inside credentials block.")
 	}
 
@@ -448,7 +460,7 @@ func resourceLaceworkIntegrationEcrCreateWithAccessKey(d *schema.ResourceData, l
 	iamRoleData := api.AwsEcrAccessKeyData{
 		AccessKeyCredentials: api.AwsEcrAccessKeyCredentials{
 			AccessKeyID:     d.Get("credentials.0.access_key_id").(string),
-			SecretAccessKey: d.Get("credentials.0.secret_access_key").(string),
+			SecretAccessKey: getWriteOnlyString(d, "credentials.0.secret_access_key"),
 		},
 		LimitByTag:       castAttributeToStringSlice(d, "limit_by_tags"),
 		LimitByRep:       castAttributeToStringSlice(d, "limit_by_repositories"),
@@ -482,6 +494,7 @@ func resourceLaceworkIntegrationEcrCreateWithAccessKey(d *schema.ResourceData, l
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	ecrData, err := castRawToAwsEcrAccessKeyData(response.Data.Data)
 	if err != nil {
@@ -550,6 +563,7 @@ func resourceLaceworkIntegrationEcrCreateWithIAMRole(d *schema.ResourceData, lac
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	ecrData, err := castRawToAwsEcrIamRoleData(response.Data.Data)
 	if err != nil {
@@ -563,7 +577,7 @@ func resourceLaceworkIntegrationEcrCreateWithIAMRole(d *schema.ResourceData, lac
 }
 
 func readEcrIam(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	response, err := lacework.V2.ContainerRegistries.GetAwsEcrIamRole(d.Id())
 	if err != nil {
 		return resourceNotFound(d, err)
@@ -577,6 +591,7 @@ func readEcrIam(d *schema.ResourceData, meta interface{}) error {
 		d.Set("created_or_updated_by", response.Data.CreatedOrUpdatedBy)
 		d.Set("type_name", response.Data.Type)
 		d.Set("org_level", response.Data.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(response.Data.State))
 
 		d.Set("non_os_package_support", response.Data.Data.NonOSPackageEval)
 		d.Set("registry_domain", response.Data.Data.RegistryDomain)
@@ -614,7 +629,7 @@ func limitByLabelsLength(labels []map[string]string) int {
 }
 
 func readEcrAccessKey(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	response, err := lacework.V2.ContainerRegistries.GetAwsEcrAccessKey(d.Id())
 	if err != nil {
 		return resourceNotFound(d, err)
@@ -628,14 +643,16 @@ func readEcrAccessKey(d *schema.ResourceData, meta interface{}) error {
 		d.Set("created_or_updated_by", response.Data.CreatedOrUpdatedBy)
 		d.Set("type_name", response.Data.Type)
 		d.Set("org_level", response.Data.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(response.Data.State))
 
 		d.Set("non_os_package_support", response.Data.Data.NonOSPackageEval)
 		d.Set("registry_domain", response.Data.Data.RegistryDomain)
 		d.Set("aws_auth_type", response.Data.Data.AwsAuthType)
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["access_key_id"] = response.Data.Data.AccessKeyCredentials.AccessKeyID
-		d.Set("credentials", []map[string]string{creds})
+		creds["secret_access_key_version"] = d.Get("credentials.0.secret_access_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 
 		d.Set("limit_num_imgs", response.Data.Data.LimitNumImg)
 		d.Set("limit_by_tags", response.Data.Data.LimitByTag)
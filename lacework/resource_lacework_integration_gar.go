@@ -42,13 +42,13 @@ var GarDomainRegistries = []string{
 
 func resourceLaceworkIntegrationGar() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationGarCreate,
+		Create: containerRegistryWaitForHealthy(resourceLaceworkIntegrationGarCreate),
 		Read:   resourceLaceworkIntegrationGarRead,
-		Update: resourceLaceworkIntegrationGarUpdate,
+		Update: containerRegistryWaitForHealthy(resourceLaceworkIntegrationGarUpdate),
 		Delete: resourceLaceworkIntegrationGarDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkContainerRegistry(api.GcpGarContainerRegistry.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,6 +108,7 @@ func resourceLaceworkIntegrationGar() *schema.Resource {
 									"name", "registry_domain", "enabled", "non_os_package_support",
 									"limit_by_tags", "limit_by_label", "limit_by_repositories",
 									"limit_num_imgs", "credentials.0.client_id", "credentials.0.client_email",
+									"credentials.0.private_key_version",
 								)
 							},
 						},
@@ -115,17 +116,9 @@ func resourceLaceworkIntegrationGar() *schema.Resource {
 							Type:      schema.TypeString,
 							Required:  true,
 							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// @afiune we can't compare this element since our API, for security reasons,
-								// does NOT return the private key configured in the Lacework server. So if
-								// any other element changed from the credentials then we trigger a diff
-								return !d.HasChanges(
-									"name", "registry_domain", "enabled", "non_os_package_support",
-									"limit_by_tags", "limit_by_label", "limit_by_repositories",
-									"limit_num_imgs", "credentials.0.client_id", "credentials.0.client_email",
-								)
-							},
+							WriteOnly: true,
 						},
+						"private_key_version": secretVersionSchema("private_key"),
 					},
 				},
 			},
@@ -196,12 +189,14 @@ func resourceLaceworkIntegrationGar() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationGarCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.GcpGarContainerRegistry,
@@ -216,7 +211,7 @@ func resourceLaceworkIntegrationGarCreate(d *schema.ResourceData, meta interface
 				ClientID:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 			},
 		},
 	)
@@ -240,6 +235,7 @@ func resourceLaceworkIntegrationGarCreate(d *schema.ResourceData, meta interface
 	d.Set("created_or_updated_by", response.Data.CreatedOrUpdatedBy)
 	d.Set("type_name", response.Data.Type)
 	d.Set("org_level", response.Data.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(response.Data.State))
 
 	log.Printf("[INFO] Created ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GcpGarContainerRegistry.String(), response.Data.IntgGuid)
@@ -247,7 +243,7 @@ func resourceLaceworkIntegrationGarCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkIntegrationGarRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GcpGarContainerRegistry.String(), d.Id())
@@ -263,12 +259,14 @@ func resourceLaceworkIntegrationGarRead(d *schema.ResourceData, meta interface{}
 	d.Set("created_or_updated_by", response.Data.CreatedOrUpdatedBy)
 	d.Set("type_name", response.Data.Type)
 	d.Set("org_level", response.Data.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(response.Data.State))
 	d.Set("non_os_package_support", response.Data.Data.NonOSPackageEval)
 
-	creds := make(map[string]string)
+	creds := make(map[string]interface{})
 	creds["client_id"] = response.Data.Data.Credentials.ClientID
 	creds["client_email"] = response.Data.Data.Credentials.ClientEmail
-	d.Set("credentials", []map[string]string{creds})
+	creds["private_key_version"] = d.Get("credentials.0.private_key_version")
+	d.Set("credentials", []map[string]interface{}{creds})
 	d.Set("registry_domain", response.Data.Data.RegistryDomain)
 	d.Set("limit_num_imgs", response.Data.Data.LimitNumImg)
 	d.Set("limit_by_tags", response.Data.Data.LimitByTag)
@@ -283,7 +281,7 @@ func resourceLaceworkIntegrationGarRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLaceworkIntegrationGarUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.GcpGarContainerRegistry,
@@ -298,7 +296,7 @@ func resourceLaceworkIntegrationGarUpdate(d *schema.ResourceData, meta interface
 				ClientID:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 			},
 		},
 	)
@@ -323,6 +321,7 @@ func resourceLaceworkIntegrationGarUpdate(d *schema.ResourceData, meta interface
 	d.Set("created_or_updated_by", response.Data.CreatedOrUpdatedBy)
 	d.Set("type_name", response.Data.Type)
 	d.Set("org_level", response.Data.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(response.Data.State))
 
 	log.Printf("[INFO] Updated ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GcpGarContainerRegistry.String(), d.Id())
@@ -330,7 +329,7 @@ func resourceLaceworkIntegrationGarUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkIntegrationGarDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GcpGarContainerRegistry.String(), d.Id())
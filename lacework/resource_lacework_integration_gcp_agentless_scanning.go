@@ -14,13 +14,13 @@ import (
 
 func resourceLaceworkIntegrationGcpAgentlessScanning() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationGcpAgentlessScanningCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationGcpAgentlessScanningCreate),
 		Read:   resourceLaceworkIntegrationGcpAgentlessScanningRead,
-		Update: resourceLaceworkIntegrationGcpAgentlessScanningUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationGcpAgentlessScanningUpdate),
 		Delete: resourceLaceworkIntegrationGcpAgentlessScanningDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.GcpSidekickCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,6 +64,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanning() *schema.Resource {
 									"name", "resource_level", "resource_id", "org_level", "enabled",
 									"credentials.0.client_id",
 									"credentials.0.client_email",
+									"credentials.0.private_key_version",
 								)
 							},
 							Description: "Private Key Id from credentials file.",
@@ -74,21 +75,13 @@ func resourceLaceworkIntegrationGcpAgentlessScanning() *schema.Resource {
 							Description: "Client email from credentials file.",
 						},
 						"private_key": {
-							Type:      schema.TypeString,
-							Required:  true,
-							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// @afiune we can't compare this element since our API, for security reasons,
-								// does NOT return the private key configured in the Lacework server. So if
-								// any other element changed from the credentials then we trigger a diff
-								return !d.HasChanges(
-									"name", "resource_level", "resource_id", "org_level", "enabled",
-									"credentials.0.client_id",
-									"credentials.0.client_email",
-								)
-							},
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
 							Description: "Private Key from credentials file.",
 						},
+						"private_key_version": secretVersionSchema("private_key"),
 						"token_uri": {
 							Type:        schema.TypeString,
 							Optional:    true,
@@ -142,6 +135,8 @@ func resourceLaceworkIntegrationGcpAgentlessScanning() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 			"server_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -214,7 +209,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanning() *schema.Resource {
 
 func resourceLaceworkIntegrationGcpAgentlessScanningCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		retries       = d.Get("retries").(int)
 		resourceLevel = api.GcpProjectIntegration
 	)
@@ -235,7 +230,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningCreate(d *schema.ResourceDat
 				ClientID:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 				TokenUri:     d.Get("credentials.0.token_uri").(string),
 			},
 			SharedBucket:            d.Get("bucket_name").(string),
@@ -287,6 +282,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningCreate(d *schema.ResourceDat
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 		d.Set("server_token", integration.ServerToken)
 		d.Set("uri", integration.Uri)
 
@@ -297,7 +293,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningCreate(d *schema.ResourceDat
 }
 
 func resourceLaceworkIntegrationGcpAgentlessScanningRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.GcpSidekickCloudAccount.String(), d.Id())
@@ -315,12 +311,14 @@ func resourceLaceworkIntegrationGcpAgentlessScanningRead(d *schema.ResourceData,
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = integration.Data.Credentials.ClientID
 		creds["client_email"] = integration.Data.Credentials.ClientEmail
 		creds["token_uri"] = integration.Data.Credentials.TokenUri
-		d.Set("credentials", []map[string]string{creds})
+		creds["private_key_version"] = d.Get("credentials.0.private_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("resource_level", integration.Data.IDType)
 		d.Set("resource_id", integration.Data.ID)
 		d.Set("bucket_name", integration.Data.SharedBucket)
@@ -349,7 +347,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningRead(d *schema.ResourceData,
 
 func resourceLaceworkIntegrationGcpAgentlessScanningUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		resourceLevel = api.GcpProjectIntegration
 	)
 
@@ -366,7 +364,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningUpdate(d *schema.ResourceDat
 				ClientID:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 				TokenUri:     d.Get("credentials.0.token_uri").(string),
 			},
 			SharedBucket:            d.Get("bucket_name").(string),
@@ -405,6 +403,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningUpdate(d *schema.ResourceDat
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	d.Set("server_token", integration.ServerToken)
 	d.Set("uri", integration.Uri)
 
@@ -414,7 +413,7 @@ func resourceLaceworkIntegrationGcpAgentlessScanningUpdate(d *schema.ResourceDat
 }
 
 func resourceLaceworkIntegrationGcpAgentlessScanningDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.GcpSidekickCloudAccount.String(), d.Id())
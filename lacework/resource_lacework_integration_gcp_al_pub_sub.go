@@ -14,12 +14,12 @@ import (
 
 func resourceLaceworkIntegrationGcpPubSubAuditLog() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceLaceworkIntegrationGcpPubSubAuditLogCreate,
+		Create:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationGcpPubSubAuditLogCreate),
 		Read:     resourceLaceworkIntegrationGcpPubSubAuditLogRead,
-		Update:   resourceLaceworkIntegrationGcpPubSubAuditLogUpdate,
+		Update:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationGcpPubSubAuditLogUpdate),
 		Delete:   resourceLaceworkIntegrationGcpPubSubAuditLogDelete,
 		Schema:   gcpPubSubAuditLogIntegrationSchema,
-		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Importer: &schema.ResourceImporter{StateContext: importLaceworkCloudAccount(api.GcpAlPubSubCloudAccount.String())},
 	}
 }
 
@@ -68,6 +68,7 @@ var gcpPubSubAuditLogIntegrationSchema = map[string]*schema.Schema{
 							"subscription", "enabled",
 							"credentials.0.client_id",
 							"credentials.0.client_email",
+							"credentials.0.private_key_version",
 						)
 					},
 				},
@@ -79,18 +80,9 @@ var gcpPubSubAuditLogIntegrationSchema = map[string]*schema.Schema{
 					Type:      schema.TypeString,
 					Required:  true,
 					Sensitive: true,
-					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						// @afiune we can't compare this element since our API, for security reasons,
-						// does NOT return the private key configured in the Lacework server. So if
-						// any other element changed from the credentials then we trigger a diff
-						return !d.HasChanges(
-							"name", "integration_type", "project_id", "organization_id",
-							"subscription_id", "enabled",
-							"credentials.0.client_id",
-							"credentials.0.client_email",
-						)
-					},
+					WriteOnly: true,
 				},
+				"private_key_version": secretVersionSchema("private_key"),
 			},
 		},
 	},
@@ -143,18 +135,20 @@ var gcpPubSubAuditLogIntegrationSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"state":            integrationStateSchema(),
+	"wait_for_healthy": integrationWaitForHealthySchema(),
 }
 
 func resourceLaceworkIntegrationGcpPubSubAuditLogCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework              = meta.(*api.Client)
+		lacework              = meta.(*providerMeta).client
 		retries               = d.Get("retries").(int)
 		gcpPubSubAuditLogData = api.GcpAlPubSubSesData{
 			Credentials: api.GcpAlPubSubCredentials{
 				ClientID:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 			},
 			IntegrationType:  strings.ToUpper(d.Get("integration_type").(string)),
 			OrganizationID:   d.Get("organization_id").(string),
@@ -210,6 +204,7 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogCreate(d *schema.ResourceData,
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("is_org", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		log.Printf("[INFO] Created %s cloud account integration with guid: %v\n",
 			api.GcpAlPubSubCloudAccount.String(), cloudAccount.IntgGuid)
@@ -218,7 +213,7 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogCreate(d *schema.ResourceData,
 }
 
 func resourceLaceworkIntegrationGcpPubSubAuditLogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.GcpAlPubSubCloudAccount.String(), d.Id())
 	response, err := lacework.V2.CloudAccounts.GetGcpAlPubSub(d.Id())
@@ -235,12 +230,14 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogRead(d *schema.ResourceData, me
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = response.Data.Data.Credentials.ClientID
 		creds["client_email"] = response.Data.Data.Credentials.ClientEmail
 
-		d.Set("credentials", []map[string]string{creds})
+		creds["private_key_version"] = d.Get("credentials.0.private_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("integration_type", cloudAccount.Data.IntegrationType)
 		d.Set("organization_id", cloudAccount.Data.OrganizationID)
 		d.Set("project_id", cloudAccount.Data.ProjectID)
@@ -259,13 +256,13 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogRead(d *schema.ResourceData, me
 
 func resourceLaceworkIntegrationGcpPubSubAuditLogUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework              = meta.(*api.Client)
+		lacework              = meta.(*providerMeta).client
 		gcpPubSubAuditLogData = api.GcpAlPubSubSesData{
 			Credentials: api.GcpAlPubSubCredentials{
 				ClientID:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 			},
 			IntegrationType:  strings.ToUpper(d.Get("integration_type").(string)),
 			OrganizationID:   d.Get("organization_id").(string),
@@ -306,13 +303,14 @@ func resourceLaceworkIntegrationGcpPubSubAuditLogUpdate(d *schema.ResourceData,
 	d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 	d.Set("type_name", cloudAccount.Type)
 	d.Set("is_org", cloudAccount.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 	log.Printf("[INFO] Updated %s cloud account integration with guid: %v\n", api.GcpAlPubSubCloudAccount.String(), d.Id())
 	return nil
 }
 
 func resourceLaceworkIntegrationGcpPubSubAuditLogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.GcpAlPubSubCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
@@ -14,13 +14,13 @@ import (
 
 func resourceLaceworkIntegrationGcpCfg() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationGcpCfgCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationGcpCfgCreate),
 		Read:   resourceLaceworkIntegrationGcpCfgRead,
-		Update: resourceLaceworkIntegrationGcpCfgUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationGcpCfgUpdate),
 		Delete: resourceLaceworkIntegrationGcpCfgDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.GcpCfgCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -61,6 +61,7 @@ func resourceLaceworkIntegrationGcpCfg() *schema.Resource {
 									"name", "resource_level", "resource_id", "org_level", "enabled",
 									"credentials.0.client_id",
 									"credentials.0.client_email",
+									"credentials.0.private_key_version",
 								)
 							},
 						},
@@ -72,17 +73,9 @@ func resourceLaceworkIntegrationGcpCfg() *schema.Resource {
 							Type:      schema.TypeString,
 							Required:  true,
 							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// @afiune we can't compare this element since our API, for security reasons,
-								// does NOT return the private key configured in the Lacework server. So if
-								// any other element changed from the credentials then we trigger a diff
-								return !d.HasChanges(
-									"name", "resource_level", "resource_id", "org_level", "enabled",
-									"credentials.0.client_id",
-									"credentials.0.client_email",
-								)
-							},
+							WriteOnly: true,
 						},
+						"private_key_version": secretVersionSchema("private_key"),
 					},
 				},
 			},
@@ -128,13 +121,15 @@ func resourceLaceworkIntegrationGcpCfg() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationGcpCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		retries       = d.Get("retries").(int)
 		resourceLevel = api.GcpProjectIntegration
 	)
@@ -154,7 +149,7 @@ func resourceLaceworkIntegrationGcpCfgCreate(d *schema.ResourceData, meta interf
 				ClientID:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 			},
 		},
 	)
@@ -195,6 +190,7 @@ func resourceLaceworkIntegrationGcpCfgCreate(d *schema.ResourceData, meta interf
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Created %s integration with guid: %v\n",
 			api.GcpCfgCloudAccount.String(), integration.IntgGuid)
@@ -203,7 +199,7 @@ func resourceLaceworkIntegrationGcpCfgCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationGcpCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.GcpCfgCloudAccount.String(), d.Id())
@@ -221,11 +217,13 @@ func resourceLaceworkIntegrationGcpCfgRead(d *schema.ResourceData, meta interfac
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = integration.Data.Credentials.ClientID
 		creds["client_email"] = integration.Data.Credentials.ClientEmail
-		d.Set("credentials", []map[string]string{creds})
+		creds["private_key_version"] = d.Get("credentials.0.private_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("resource_level", integration.Data.IDType)
 		d.Set("resource_id", integration.Data.ID)
 
@@ -240,7 +238,7 @@ func resourceLaceworkIntegrationGcpCfgRead(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkIntegrationGcpCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework      = meta.(*api.Client)
+		lacework      = meta.(*providerMeta).client
 		resourceLevel = api.GcpProjectIntegration
 	)
 
@@ -257,7 +255,7 @@ func resourceLaceworkIntegrationGcpCfgUpdate(d *schema.ResourceData, meta interf
 				ClientID:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 			},
 		},
 	)
@@ -286,6 +284,7 @@ func resourceLaceworkIntegrationGcpCfgUpdate(d *schema.ResourceData, meta interf
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Updated %s integration with guid: %v\n",
 		api.GcpCfgCloudAccount.String(), d.Id())
@@ -293,7 +292,7 @@ func resourceLaceworkIntegrationGcpCfgUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationGcpCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.GcpCfgCloudAccount.String(), d.Id())
@@ -14,12 +14,12 @@ import (
 
 func resourceLaceworkIntegrationGcpGkeAuditLog() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceLaceworkIntegrationGcpGkeAuditLogCreate,
+		Create:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationGcpGkeAuditLogCreate),
 		Read:     resourceLaceworkIntegrationGcpGkeAuditLogRead,
-		Update:   resourceLaceworkIntegrationGcpGkeAuditLogUpdate,
+		Update:   cloudAccountWaitForHealthy(resourceLaceworkIntegrationGcpGkeAuditLogUpdate),
 		Delete:   resourceLaceworkIntegrationGcpGkeAuditLogDelete,
 		Schema:   gcpGkeAuditLogIntegrationSchema,
-		Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		Importer: &schema.ResourceImporter{StateContext: importLaceworkCloudAccount(api.GcpGkeAuditCloudAccount.String())},
 	}
 }
 
@@ -68,6 +68,7 @@ var gcpGkeAuditLogIntegrationSchema = map[string]*schema.Schema{
 							"subscription", "enabled",
 							"credentials.0.client_id",
 							"credentials.0.client_email",
+							"credentials.0.private_key_version",
 						)
 					},
 				},
@@ -79,18 +80,9 @@ var gcpGkeAuditLogIntegrationSchema = map[string]*schema.Schema{
 					Type:      schema.TypeString,
 					Required:  true,
 					Sensitive: true,
-					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						// @afiune we can't compare this element since our API, for security reasons,
-						// does NOT return the private key configured in the Lacework server. So if
-						// any other element changed from the credentials then we trigger a diff
-						return !d.HasChanges(
-							"name", "integration_type", "project_id", "organization_id",
-							"subscription", "enabled",
-							"credentials.0.client_id",
-							"credentials.0.client_email",
-						)
-					},
+					WriteOnly: true,
 				},
+				"private_key_version": secretVersionSchema("private_key"),
 			},
 		},
 	},
@@ -138,18 +130,20 @@ var gcpGkeAuditLogIntegrationSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"state":            integrationStateSchema(),
+	"wait_for_healthy": integrationWaitForHealthySchema(),
 }
 
 func resourceLaceworkIntegrationGcpGkeAuditLogCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		retries            = d.Get("retries").(int)
 		gcpGkeAuditLogData = api.GcpGkeAuditData{
 			Credentials: api.GcpGkeAuditCredentials{
 				ClientId:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyId: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 			},
 			IntegrationType:  strings.ToUpper(d.Get("integration_type").(string)),
 			OrganizationId:   d.Get("organization_id").(string),
@@ -204,6 +198,7 @@ func resourceLaceworkIntegrationGcpGkeAuditLogCreate(d *schema.ResourceData, met
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type) // @afiune should we deprecate?
 		d.Set("is_org", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 		log.Printf("[INFO] Created %s cloud account integration with guid: %v\n",
 			api.GcpGkeAuditCloudAccount.String(), cloudAccount.IntgGuid)
@@ -212,7 +207,7 @@ func resourceLaceworkIntegrationGcpGkeAuditLogCreate(d *schema.ResourceData, met
 }
 
 func resourceLaceworkIntegrationGcpGkeAuditLogRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s cloud account integration with guid: %v\n", api.GcpGkeAuditCloudAccount.String(), d.Id())
 	response, err := lacework.V2.CloudAccounts.GetGcpGkeAudit(d.Id())
@@ -229,12 +224,14 @@ func resourceLaceworkIntegrationGcpGkeAuditLogRead(d *schema.ResourceData, meta
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = response.Data.Data.Credentials.ClientId
 		creds["client_email"] = response.Data.Data.Credentials.ClientEmail
 
-		d.Set("credentials", []map[string]string{creds})
+		creds["private_key_version"] = d.Get("credentials.0.private_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("integration_type", cloudAccount.Data.IntegrationType)
 		d.Set("organization_id", cloudAccount.Data.OrganizationId)
 		d.Set("project_id", cloudAccount.Data.ProjectId)
@@ -252,13 +249,13 @@ func resourceLaceworkIntegrationGcpGkeAuditLogRead(d *schema.ResourceData, meta
 
 func resourceLaceworkIntegrationGcpGkeAuditLogUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework           = meta.(*api.Client)
+		lacework           = meta.(*providerMeta).client
 		gcpGkeAuditLogData = api.GcpGkeAuditData{
 			Credentials: api.GcpGkeAuditCredentials{
 				ClientId:     d.Get("credentials.0.client_id").(string),
 				ClientEmail:  d.Get("credentials.0.client_email").(string),
 				PrivateKeyId: d.Get("credentials.0.private_key_id").(string),
-				PrivateKey:   d.Get("credentials.0.private_key").(string),
+				PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 			},
 			IntegrationType:  strings.ToUpper(d.Get("integration_type").(string)),
 			OrganizationId:   d.Get("organization_id").(string),
@@ -298,13 +295,14 @@ func resourceLaceworkIntegrationGcpGkeAuditLogUpdate(d *schema.ResourceData, met
 	d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 	d.Set("type_name", cloudAccount.Type)
 	d.Set("is_org", cloudAccount.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(cloudAccount.State))
 
 	log.Printf("[INFO] Updated %s cloud account integration with guid: %v\n", api.GcpGkeAuditCloudAccount.String(), d.Id())
 	return nil
 }
 
 func resourceLaceworkIntegrationGcpGkeAuditLogDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s cloud account integration with guid: %v\n", api.GcpGkeAuditCloudAccount.String(), d.Id())
 	err := lacework.V2.CloudAccounts.Delete(d.Id())
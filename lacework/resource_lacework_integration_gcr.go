@@ -12,13 +12,13 @@ import (
 
 func resourceLaceworkIntegrationGcr() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationGcrCreate,
+		Create: containerRegistryWaitForHealthy(resourceLaceworkIntegrationGcrCreate),
 		Read:   resourceLaceworkIntegrationGcrRead,
-		Update: resourceLaceworkIntegrationGcrUpdate,
+		Update: containerRegistryWaitForHealthy(resourceLaceworkIntegrationGcrUpdate),
 		Delete: resourceLaceworkIntegrationGcrDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkContainerRegistry(api.GcpGcrContainerRegistry.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,6 +66,7 @@ func resourceLaceworkIntegrationGcr() *schema.Resource {
 									"credentials.0.client_id",
 									"credentials.0.client_email", "limit_num_imgs",
 									"limit_by_tags", "limit_by_label", "limit_by_repositories",
+									"credentials.0.private_key_version",
 								)
 							},
 						},
@@ -77,15 +78,9 @@ func resourceLaceworkIntegrationGcr() *schema.Resource {
 							Type:      schema.TypeString,
 							Required:  true,
 							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								return !d.HasChanges(
-									"name", "org_level", "enabled",
-									"credentials.0.client_id",
-									"credentials.0.client_email", "limit_num_imgs",
-									"limit_by_tags", "limit_by_label", "limit_by_repositories",
-								)
-							},
+							WriteOnly: true,
 						},
+						"private_key_version": secretVersionSchema("private_key"),
 					},
 				},
 			},
@@ -160,12 +155,14 @@ func resourceLaceworkIntegrationGcr() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationGcrCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	gcrData := api.GcpGcrData{
 		LimitByTag:       castAttributeToStringSlice(d, "limit_by_tags"),
 		LimitByRep:       castAttributeToStringSlice(d, "limit_by_repositories"),
@@ -177,7 +174,7 @@ func resourceLaceworkIntegrationGcrCreate(d *schema.ResourceData, meta interface
 			ClientID:     d.Get("credentials.0.client_id").(string),
 			ClientEmail:  d.Get("credentials.0.client_email").(string),
 			PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-			PrivateKey:   d.Get("credentials.0.private_key").(string),
+			PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 		},
 	}
 
@@ -204,13 +201,14 @@ func resourceLaceworkIntegrationGcrCreate(d *schema.ResourceData, meta interface
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Created %s registry type with guid: %v\n", api.GcpGcrContainerRegistry.String(), integration.IntgGuid)
 	return nil
 }
 
 func resourceLaceworkIntegrationGcrRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s registry type with guid: %v\n", api.GcpGcrContainerRegistry.String(), d.Id())
 	response, err := lacework.V2.ContainerRegistries.GetGcpGcr(d.Id())
@@ -228,11 +226,13 @@ func resourceLaceworkIntegrationGcrRead(d *schema.ResourceData, meta interface{}
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		creds["client_id"] = integration.Data.Credentials.ClientID
 		creds["client_email"] = integration.Data.Credentials.ClientEmail
-		d.Set("credentials", []map[string]string{creds})
+		creds["private_key_version"] = d.Get("credentials.0.private_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("registry_domain", integration.Data.RegistryDomain)
 		d.Set("limit_num_imgs", integration.Data.LimitNumImg)
 		d.Set("non_os_package_support", integration.Data.NonOSPackageEval)
@@ -251,7 +251,7 @@ func resourceLaceworkIntegrationGcrRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceLaceworkIntegrationGcrUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	gcrData := api.GcpGcrData{
 		LimitByTag:       castAttributeToStringSlice(d, "limit_by_tags"),
@@ -264,7 +264,7 @@ func resourceLaceworkIntegrationGcrUpdate(d *schema.ResourceData, meta interface
 			ClientID:     d.Get("credentials.0.client_id").(string),
 			ClientEmail:  d.Get("credentials.0.client_email").(string),
 			PrivateKeyID: d.Get("credentials.0.private_key_id").(string),
-			PrivateKey:   d.Get("credentials.0.private_key").(string),
+			PrivateKey:   getWriteOnlyString(d, "credentials.0.private_key"),
 		},
 	}
 
@@ -293,6 +293,7 @@ func resourceLaceworkIntegrationGcrUpdate(d *schema.ResourceData, meta interface
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Updated %s registry type with guid: %v\n", api.GcpGcrContainerRegistry.String(), d.Id())
 
@@ -303,7 +304,7 @@ func resourceLaceworkIntegrationGcrUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceLaceworkIntegrationGcrDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s registry type with guid: %v\n", api.GcpGcrContainerRegistry.String(), d.Id())
 
@@ -11,13 +11,13 @@ import (
 
 func resourceLaceworkIntegrationGhcr() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationGhcrCreate,
+		Create: containerRegistryWaitForHealthy(resourceLaceworkIntegrationGhcrCreate),
 		Read:   resourceLaceworkIntegrationGhcrRead,
-		Update: resourceLaceworkIntegrationGhcrUpdate,
+		Update: containerRegistryWaitForHealthy(resourceLaceworkIntegrationGhcrUpdate),
 		Delete: resourceLaceworkIntegrationGhcrDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkContainerRegistry(api.GhcrContainerRegistry.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -46,9 +46,11 @@ func resourceLaceworkIntegrationGhcr() *schema.Resource {
 			"password": {
 				Type:        schema.TypeString,
 				Sensitive:   true,
+				WriteOnly:   true,
 				Required:    true,
 				Description: "The Github personal access token with read:packages",
 			},
+			"password_version": secretVersionSchema("password"),
 			"ssl": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -131,12 +133,14 @@ func resourceLaceworkIntegrationGhcr() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationGhcrCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.GhcrContainerRegistry,
@@ -149,7 +153,7 @@ func resourceLaceworkIntegrationGhcrCreate(d *schema.ResourceData, meta interfac
 			NonOSPackageEval:      d.Get("non_os_package_support").(bool),
 			Credentials: api.GhcrCredentials{
 				Username: d.Get("username").(string),
-				Password: d.Get("password").(string),
+				Password: getWriteOnlyString(d, "password"),
 				Ssl:      d.Get("ssl").(bool),
 			},
 		},
@@ -174,6 +178,7 @@ func resourceLaceworkIntegrationGhcrCreate(d *schema.ResourceData, meta interfac
 	d.Set("created_or_updated_by", response.Data.CreatedOrUpdatedBy)
 	d.Set("type_name", response.Data.Type)
 	d.Set("org_level", response.Data.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(response.Data.State))
 
 	log.Printf("[INFO] Created ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GhcrContainerRegistry.String(), response.Data.IntgGuid)
@@ -181,7 +186,7 @@ func resourceLaceworkIntegrationGhcrCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkIntegrationGhcrRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GhcrContainerRegistry.String(), d.Id())
@@ -197,6 +202,7 @@ func resourceLaceworkIntegrationGhcrRead(d *schema.ResourceData, meta interface{
 	d.Set("created_or_updated_by", response.Data.CreatedOrUpdatedBy)
 	d.Set("type_name", response.Data.Type)
 	d.Set("org_level", response.Data.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(response.Data.State))
 
 	d.Set("username", response.Data.Data.Credentials.Username)
 	d.Set("ssl", response.Data.Data.Credentials.Ssl)
@@ -217,7 +223,7 @@ func resourceLaceworkIntegrationGhcrRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceLaceworkIntegrationGhcrUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.GhcrContainerRegistry,
@@ -230,7 +236,7 @@ func resourceLaceworkIntegrationGhcrUpdate(d *schema.ResourceData, meta interfac
 			NonOSPackageEval:      d.Get("non_os_package_support").(bool),
 			Credentials: api.GhcrCredentials{
 				Username: d.Get("username").(string),
-				Password: d.Get("password").(string),
+				Password: getWriteOnlyString(d, "password"),
 				Ssl:      d.Get("ssl").(bool),
 			},
 		},
@@ -256,6 +262,7 @@ func resourceLaceworkIntegrationGhcrUpdate(d *schema.ResourceData, meta interfac
 	d.Set("created_or_updated_by", response.Data.CreatedOrUpdatedBy)
 	d.Set("type_name", response.Data.Type)
 	d.Set("org_level", response.Data.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(response.Data.State))
 
 	log.Printf("[INFO] Updated ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GhcrContainerRegistry.String(), d.Id())
@@ -263,7 +270,7 @@ func resourceLaceworkIntegrationGhcrUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkIntegrationGhcrDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting ContVulnCfg integration for %s registry type with guid %s\n",
 		api.GhcrContainerRegistry.String(), d.Id())
@@ -11,13 +11,13 @@ import (
 
 func resourceLaceworkIntegrationInlineScanner() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationInlineScannerCreate,
+		Create: containerRegistryWaitForHealthy(resourceLaceworkIntegrationInlineScannerCreate),
 		Read:   resourceLaceworkIntegrationInlineScannerRead,
-		Update: resourceLaceworkIntegrationInlineScannerUpdate,
+		Update: containerRegistryWaitForHealthy(resourceLaceworkIntegrationInlineScannerUpdate),
 		Delete: resourceLaceworkIntegrationInlineScannerDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkContainerRegistry(api.InlineScannerContainerRegistry.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,6 +76,8 @@ func resourceLaceworkIntegrationInlineScanner() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 			"server_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -89,7 +91,7 @@ func resourceLaceworkIntegrationInlineScanner() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationInlineScannerCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(
 		d.Get("name").(string),
@@ -120,6 +122,7 @@ func resourceLaceworkIntegrationInlineScannerCreate(d *schema.ResourceData, meta
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	d.Set("server_token", integration.ServerToken.ServerToken)
 	d.Set("server_uri", integration.ServerToken.Uri)
 
@@ -130,7 +133,7 @@ func resourceLaceworkIntegrationInlineScannerCreate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationInlineScannerRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading ContVulnCfg integration for %s registry type with guid %s\n",
 		api.InlineScannerContainerRegistry.String(), d.Id())
@@ -147,6 +150,7 @@ func resourceLaceworkIntegrationInlineScannerRead(d *schema.ResourceData, meta i
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	d.Set("server_token", integration.ServerToken.ServerToken)
 	d.Set("server_uri", integration.ServerToken.Uri)
 
@@ -162,7 +166,7 @@ func resourceLaceworkIntegrationInlineScannerRead(d *schema.ResourceData, meta i
 }
 
 func resourceLaceworkIntegrationInlineScannerUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.InlineScannerContainerRegistry,
@@ -193,6 +197,7 @@ func resourceLaceworkIntegrationInlineScannerUpdate(d *schema.ResourceData, meta
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	d.Set("server_token", integration.ServerToken.ServerToken)
 	d.Set("server_uri", integration.ServerToken.Uri)
 
@@ -208,7 +213,7 @@ func resourceLaceworkIntegrationInlineScannerUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationInlineScannerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting ContVulnCfg integration for %s registry type with guid %s\n",
 		api.InlineScannerContainerRegistry.String(), d.Id())
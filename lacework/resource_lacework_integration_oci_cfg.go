@@ -12,13 +12,13 @@ import (
 
 func resourceLaceworkIntegrationOciCfg() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationOciCfgCreate,
+		Create: cloudAccountWaitForHealthy(resourceLaceworkIntegrationOciCfgCreate),
 		Read:   resourceLaceworkIntegrationOciCfgRead,
-		Update: resourceLaceworkIntegrationOciCfgUpdate,
+		Update: cloudAccountWaitForHealthy(resourceLaceworkIntegrationOciCfgUpdate),
 		Delete: resourceLaceworkIntegrationOciCfgDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkCloudAccount(api.OciCfgCloudAccount.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,16 +55,9 @@ func resourceLaceworkIntegrationOciCfg() *schema.Resource {
 							Type:      schema.TypeString,
 							Required:  true,
 							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// we can't compare this element since our API, for security reasons,
-								// does NOT return the private key configured in the Lacework server. So if
-								// any other element changed from the credentials then we trigger a diff
-								return !d.HasChanges(
-									"name", "home_region", "tenant_id", "tenant_name",
-									"enabled", "user_ocid", "credentials.0.fingerprint",
-								)
-							},
+							WriteOnly: true,
 						},
+						"private_key_version": secretVersionSchema("private_key"),
 					},
 				},
 			},
@@ -100,20 +93,22 @@ func resourceLaceworkIntegrationOciCfg() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 		},
 	}
 }
 
 func resourceLaceworkIntegrationOciCfgCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		retries  = d.Get("retries").(int)
 		oci      = api.NewCloudAccount(d.Get("name").(string),
 			api.OciCfgCloudAccount,
 			api.OciCfgData{
 				Credentials: api.OciCfgCredentials{
 					Fingerprint: d.Get("credentials.0.fingerprint").(string),
-					PrivateKey:  d.Get("credentials.0.private_key").(string),
+					PrivateKey:  getWriteOnlyString(d, "credentials.0.private_key"),
 				},
 				HomeRegion: d.Get("home_region").(string),
 				TenantID:   d.Get("tenant_id").(string),
@@ -156,6 +151,7 @@ func resourceLaceworkIntegrationOciCfgCreate(d *schema.ResourceData, meta interf
 		d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 		d.Set("type_name", integration.Type)
 		d.Set("org_level", integration.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(integration.State))
 
 		log.Printf("[INFO] Created %s integration with guid: %v\n",
 			api.OciCfgCloudAccount.String(), integration.IntgGuid)
@@ -165,7 +161,7 @@ func resourceLaceworkIntegrationOciCfgCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationOciCfgRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading %s integration with guid: %v\n",
 		api.OciCfgCloudAccount.String(), d.Id())
@@ -183,12 +179,13 @@ func resourceLaceworkIntegrationOciCfgRead(d *schema.ResourceData, meta interfac
 		d.Set("created_or_updated_by", cloudAccount.CreatedOrUpdatedBy)
 		d.Set("type_name", cloudAccount.Type)
 		d.Set("org_level", cloudAccount.IsOrg == 1)
+		d.Set("state", flattenIntegrationState(cloudAccount.State))
 
-		creds := make(map[string]string)
+		creds := make(map[string]interface{})
 		credentials := cloudAccount.Data.Credentials
 		creds["fingerprint"] = credentials.Fingerprint
-		creds["private_key"] = credentials.PrivateKey
-		d.Set("credentials", []map[string]string{creds})
+		creds["private_key_version"] = d.Get("credentials.0.private_key_version")
+		d.Set("credentials", []map[string]interface{}{creds})
 		d.Set("home_region", cloudAccount.Data.HomeRegion)
 		d.Set("tenant_id", cloudAccount.Data.TenantID)
 		d.Set("tenant_name", cloudAccount.Data.TenantName)
@@ -205,13 +202,13 @@ func resourceLaceworkIntegrationOciCfgRead(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkIntegrationOciCfgUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		oci      = api.NewCloudAccount(d.Get("name").(string),
 			api.OciCfgCloudAccount,
 			api.OciCfgData{
 				Credentials: api.OciCfgCredentials{
 					Fingerprint: d.Get("credentials.0.fingerprint").(string),
-					PrivateKey:  d.Get("credentials.0.private_key").(string),
+					PrivateKey:  getWriteOnlyString(d, "credentials.0.private_key"),
 				},
 				HomeRegion: d.Get("home_region").(string),
 				TenantID:   d.Get("tenant_id").(string),
@@ -241,6 +238,7 @@ func resourceLaceworkIntegrationOciCfgUpdate(d *schema.ResourceData, meta interf
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 
 	log.Printf("[INFO] Updated %s integration with guid: %v\n",
 		api.OciCfgCloudAccount.String(), d.Id())
@@ -248,7 +246,7 @@ func resourceLaceworkIntegrationOciCfgUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceLaceworkIntegrationOciCfgDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting %s integration with guid: %v\n",
 		api.OciCfgCloudAccount.String(), d.Id())
@@ -11,13 +11,13 @@ import (
 
 func resourceLaceworkIntegrationProxyScanner() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLaceworkIntegrationProxyScannerCreate,
+		Create: containerRegistryWaitForHealthy(resourceLaceworkIntegrationProxyScannerCreate),
 		Read:   resourceLaceworkIntegrationProxyScannerRead,
-		Update: resourceLaceworkIntegrationProxyScannerUpdate,
+		Update: containerRegistryWaitForHealthy(resourceLaceworkIntegrationProxyScannerUpdate),
 		Delete: resourceLaceworkIntegrationProxyScannerDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importLaceworkContainerRegistry(api.ProxyScannerContainerRegistry.String()),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,6 +101,8 @@ func resourceLaceworkIntegrationProxyScanner() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"state":            integrationStateSchema(),
+			"wait_for_healthy": integrationWaitForHealthySchema(),
 			"server_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -114,7 +116,7 @@ func resourceLaceworkIntegrationProxyScanner() *schema.Resource {
 }
 
 func resourceLaceworkIntegrationProxyScannerCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(
 		d.Get("name").(string),
@@ -149,6 +151,7 @@ func resourceLaceworkIntegrationProxyScannerCreate(d *schema.ResourceData, meta
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	d.Set("server_token", integration.ServerToken.ServerToken)
 	d.Set("server_uri", integration.ServerToken.Uri)
 
@@ -159,7 +162,7 @@ func resourceLaceworkIntegrationProxyScannerCreate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationProxyScannerRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading ContVulnCfg integration for %s registry type with guid %s\n",
 		api.ProxyScannerContainerRegistry.String(), d.Id())
@@ -176,6 +179,7 @@ func resourceLaceworkIntegrationProxyScannerRead(d *schema.ResourceData, meta in
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	d.Set("server_token", integration.ServerToken.ServerToken)
 	d.Set("server_uri", integration.ServerToken.Uri)
 
@@ -190,7 +194,7 @@ func resourceLaceworkIntegrationProxyScannerRead(d *schema.ResourceData, meta in
 }
 
 func resourceLaceworkIntegrationProxyScannerUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	data := api.NewContainerRegistry(d.Get("name").(string),
 		api.ProxyScannerContainerRegistry,
@@ -223,6 +227,7 @@ func resourceLaceworkIntegrationProxyScannerUpdate(d *schema.ResourceData, meta
 	d.Set("created_or_updated_by", integration.CreatedOrUpdatedBy)
 	d.Set("type_name", integration.Type)
 	d.Set("org_level", integration.IsOrg == 1)
+	d.Set("state", flattenIntegrationState(integration.State))
 	d.Set("server_token", integration.ServerToken.ServerToken)
 	d.Set("server_uri", integration.ServerToken.Uri)
 
@@ -237,7 +242,7 @@ func resourceLaceworkIntegrationProxyScannerUpdate(d *schema.ResourceData, meta
 }
 
 func resourceLaceworkIntegrationProxyScannerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting ContVulnCfg integration for %s registry type with guid %s\n",
 		api.ProxyScannerContainerRegistry.String(), d.Id())
@@ -0,0 +1,139 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLaceworkInventoryScan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLaceworkInventoryScanCreate,
+		Read:   resourceLaceworkInventoryScanRead,
+		Update: resourceLaceworkInventoryScanUpdate,
+		Delete: resourceLaceworkInventoryScanDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  fmt.Sprintf("The cloud provider of the inventory to scan, one of %v", inventoryClouds),
+				ValidateFunc: validation.StringInSlice(inventoryClouds, false),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that trigger a new scan when they change",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Wait until the inventory contains resources observed after the scan was " +
+					"triggered, defaults to false",
+			},
+			"scan_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the scan was triggered",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the scan returned when it was triggered",
+			},
+			"details": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The details of the scan returned when it was triggered",
+			},
+		},
+	}
+}
+
+func resourceLaceworkInventoryScanCreate(d *schema.ResourceData, meta interface{}) error {
+	var (
+		lacework = meta.(*providerMeta).client
+		cloud    = d.Get("cloud").(string)
+		scanTime = time.Now().UTC()
+	)
+
+	search, err := newInventorySearch(cloud, scanTime, scanTime)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Triggering scan of the %s inventory\n", cloud)
+	response, err := lacework.V2.Inventory.Scan(search.Csp)
+	if err != nil {
+		return fmt.Errorf("unable to trigger the scan of the %s inventory: %s", cloud, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", cloud, scanTime.Format(time.RFC3339)))
+	d.Set("scan_time", scanTime.Format(time.RFC3339))
+	d.Set("status", response.Data.Status)
+	d.Set("details", response.Data.Details)
+	log.Printf("[INFO] Triggered scan of the %s inventory. status=%s, details=%s\n",
+		cloud, response.Data.Status, response.Data.Details)
+
+	if !d.Get("wait_for_completion").(bool) {
+		return nil
+	}
+
+	// the scan endpoint doesn't report the progress of the scan, the scan is complete
+	// once the inventory contains resources observed after it was triggered
+	search.Returns = []string{"urn"}
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = retry.RetryContext(context.Background(), timeout, func() *retry.RetryError {
+		now := time.Now().UTC()
+		search.TimeFilter.EndTime = &now
+
+		resources, err := searchInventory(lacework, search)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if len(resources) == 0 {
+			return retry.RetryableError(fmt.Errorf(
+				"the scan of the %s inventory triggered at %s did not complete after %s",
+				cloud, scanTime.Format(time.RFC3339), timeout,
+			))
+		}
+
+		log.Printf("[INFO] Scan of the %s inventory completed with %d resources\n", cloud, len(resources))
+		return nil
+	})
+	// the scan was triggered, a failed wait taints the resource so the next apply scans again
+	return err
+}
+
+// resourceLaceworkInventoryScanRead keeps the state of the scan, a scan is triggered
+// once and can't be read from the Lacework API
+func resourceLaceworkInventoryScanRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// resourceLaceworkInventoryScanUpdate only updates wait_for_completion, the other
+// arguments trigger a new scan
+func resourceLaceworkInventoryScanUpdate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceLaceworkInventoryScanDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Removing inventory scan %s from the state\n", d.Id())
+	d.SetId("")
+	return nil
+}
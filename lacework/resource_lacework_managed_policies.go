@@ -1,8 +1,10 @@
 package lacework
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,6 +12,8 @@ import (
 	"github.com/lacework/go-sdk/v2/api"
 )
 
+const laceworkGlobalPolicyPrefix = "lacework-global"
+
 func resourceLaceworkManagedPolicies() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLaceworkManagedPoliciesCreate,
@@ -17,6 +21,8 @@ func resourceLaceworkManagedPolicies() *schema.Resource {
 		Delete: resourceLaceworkManagedPoliciesDelete,
 		Read:   resourceLaceworkManagedPoliciesRead,
 
+		CustomizeDiff: resourceLaceworkManagedPoliciesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"policy": {
 				Type: schema.TypeSet,
@@ -47,6 +53,66 @@ func resourceLaceworkManagedPolicies() *schema.Resource {
 				Required:    true,
 				Description: "A list of Lacework managed policies",
 			},
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Capture the original state and severity of every managed policy and restore " +
+					"it on destroy, policies matching the selector are kept at their original state",
+			},
+			"selector": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Select the Lacework managed policies to monitor for drift in authoritative mode",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Select policies that have all of the provided tags",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"id_prefix": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "Select policies whose id starts with the provided prefix",
+							ValidateDiagFunc: StringHasPrefix(laceworkGlobalPolicyPrefix),
+						},
+					},
+				},
+			},
+			"baseline": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The original state and severity of every policy touched in authoritative mode",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"drifted_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The ids of selected policies that were changed outside of Terraform",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -57,24 +123,45 @@ func resourceLaceworkManagedPoliciesCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkManagedPoliciesUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 	policies, err := getBulkUpdatePolicies(d)
 
 	if err != nil {
 		return err
 	}
 
+	var baseline map[string]api.BulkUpdatePolicy
+	if d.Get("authoritative").(bool) {
+		policies, baseline, err = authoritativeBulkUpdatePolicies(d, lacework, policies)
+		if err != nil {
+			return err
+		}
+	} else {
+		// leaving authoritative mode restores the policies that are no longer managed
+		policies = restoreBaselineBulkUpdatePolicies(getManagedPoliciesBaseline(d), policies)
+	}
+
 	log.Printf("[INFO] Updating Policies with data:\n%+v\n", policies)
 	_, updateErr := lacework.V2.Policy.UpdateMany(policies)
 	if updateErr != nil {
 		return updateErr
 	}
 	log.Printf("[INFO] Updated Policies with data:\n%+v\n", policies)
+
+	// the baseline is only recorded once the policies are updated, so a failed
+	// update never loses the original state of the policies
+	if d.Get("authoritative").(bool) {
+		d.Set("baseline", flattenManagedPoliciesBaseline(baseline))
+		d.Set("drifted_policies", []string{})
+	} else {
+		d.Set("baseline", nil)
+		d.Set("drifted_policies", nil)
+	}
 	return nil
 }
 
 func resourceLaceworkManagedPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	policiesListResponse, err := lacework.V2.Policy.List()
 
@@ -106,14 +193,222 @@ func resourceLaceworkManagedPoliciesRead(d *schema.ResourceData, meta interface{
 
 	d.Set("policy", policySet)
 
+	if d.Get("authoritative").(bool) {
+		d.Set("drifted_policies", driftedManagedPolicies(d, bulkUpdatePolicies, policiesListResponse.Data))
+	}
+
 	return nil
 }
 
 func resourceLaceworkManagedPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	if d.Get("authoritative").(bool) {
+		if policies := restoreBaselineBulkUpdatePolicies(getManagedPoliciesBaseline(d), nil); len(policies) != 0 {
+			log.Printf("[INFO] Restoring Policies baseline with data:\n%+v\n", policies)
+			if _, err := lacework.V2.Policy.UpdateMany(policies); err != nil {
+				return err
+			}
+			log.Printf("[INFO] Restored Policies baseline with data:\n%+v\n", policies)
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
 
+// resourceLaceworkManagedPoliciesCustomizeDiff surfaces drift on unmanaged
+// policies in authoritative mode, when the last refresh detected that a
+// selected policy was changed outside of Terraform, the plan resets the list
+// of drifted policies which triggers an update that restores their baseline
+func resourceLaceworkManagedPoliciesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.Get("authoritative").(bool) {
+		return nil
+	}
+
+	if d.HasChanges("authoritative", "policy", "selector") {
+		if err := d.SetNewComputed("baseline"); err != nil {
+			return err
+		}
+	}
+
+	if len(d.Get("drifted_policies").([]interface{})) != 0 {
+		return d.SetNew("drifted_policies", []string{})
+	}
+	return nil
+}
+
+// authoritativeBulkUpdatePolicies captures the baseline of every policy touched
+// by the resource and returns the list of policies to update, which includes the
+// configured policies, the selected policies that must stay at their baseline and
+// the policies that are no longer managed and need to be restored, along with the
+// baseline to record once the policies are updated
+func authoritativeBulkUpdatePolicies(
+	d *schema.ResourceData, lacework *api.Client, managed api.BulkUpdatePolicies,
+) (api.BulkUpdatePolicies, map[string]api.BulkUpdatePolicy, error) {
+	response, err := lacework.V2.Policy.List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		baseline    = getManagedPoliciesBaseline(d)
+		managedIDs  = make(map[string]bool, len(managed))
+		selectedIDs = make(map[string]bool)
+		policies    = managed
+		selector    = getManagedPoliciesSelector(d)
+	)
+
+	for _, policy := range managed {
+		managedIDs[policy.PolicyID] = true
+	}
+
+	for _, policy := range response.Data {
+		if selector != nil && selector.match(policy) {
+			selectedIDs[policy.PolicyID] = true
+		}
+
+		if !managedIDs[policy.PolicyID] && !selectedIDs[policy.PolicyID] {
+			continue
+		}
+
+		// the baseline of a policy is captured only once, the first time it is touched
+		if _, ok := baseline[policy.PolicyID]; !ok {
+			enabled := policy.Enabled
+			baseline[policy.PolicyID] = api.BulkUpdatePolicy{
+				PolicyID: policy.PolicyID,
+				Enabled:  &enabled,
+				Severity: strings.ToLower(policy.Severity),
+			}
+		}
+	}
+
+	for id, original := range baseline {
+		if managedIDs[id] {
+			continue
+		}
+
+		// selected policies are kept at their baseline, policies that are neither
+		// managed nor selected anymore are restored and released from the baseline
+		policies = append(policies, original)
+		if !selectedIDs[id] {
+			delete(baseline, id)
+		}
+	}
+
+	sortBulkUpdatePolicies(policies)
+	return policies, baseline, nil
+}
+
+// restoreBaselineBulkUpdatePolicies returns the managed policies along with the baseline
+// of the policies that are not managed, which restores them to their original state
+func restoreBaselineBulkUpdatePolicies(
+	baseline map[string]api.BulkUpdatePolicy, managed api.BulkUpdatePolicies,
+) api.BulkUpdatePolicies {
+	managedIDs := make(map[string]bool, len(managed))
+	for _, policy := range managed {
+		managedIDs[policy.PolicyID] = true
+	}
+
+	policies := managed
+	for id, original := range baseline {
+		if !managedIDs[id] {
+			policies = append(policies, original)
+		}
+	}
+	sortBulkUpdatePolicies(policies)
+	return policies
+}
+
+// driftedManagedPolicies returns the ids of the selected policies that are not
+// configured in the resource and no longer match their baseline
+func driftedManagedPolicies(d *schema.ResourceData, managed api.BulkUpdatePolicies, current []api.Policy) []string {
+	selector := getManagedPoliciesSelector(d)
+	if selector == nil {
+		return []string{}
+	}
+
+	var (
+		baseline   = getManagedPoliciesBaseline(d)
+		managedIDs = make(map[string]bool, len(managed))
+		drifted    = make([]string, 0)
+	)
+
+	for _, policy := range managed {
+		managedIDs[policy.PolicyID] = true
+	}
+
+	for _, policy := range current {
+		if managedIDs[policy.PolicyID] || !selector.match(policy) {
+			continue
+		}
+
+		original, ok := baseline[policy.PolicyID]
+		if !ok {
+			continue
+		}
+
+		if *original.Enabled != policy.Enabled || !strings.EqualFold(original.Severity, policy.Severity) {
+			log.Printf("[INFO] Policy %s drifted from its baseline\n", policy.PolicyID)
+			drifted = append(drifted, policy.PolicyID)
+		}
+	}
+
+	sort.Strings(drifted)
+	return drifted
+}
+
+func getManagedPoliciesSelector(d *schema.ResourceData) *policiesFilter {
+	if len(d.Get("selector").([]interface{})) == 0 {
+		return nil
+	}
+
+	idPrefix := d.Get("selector.0.id_prefix").(string)
+	if idPrefix == "" {
+		idPrefix = laceworkGlobalPolicyPrefix
+	}
+
+	return &policiesFilter{
+		tags:     castStringSlice(d.Get("selector.0.tags").(*schema.Set).List()),
+		idPrefix: idPrefix,
+	}
+}
+
+func getManagedPoliciesBaseline(d *schema.ResourceData) map[string]api.BulkUpdatePolicy {
+	list := d.Get("baseline").(*schema.Set).List()
+	baseline := make(map[string]api.BulkUpdatePolicy, len(list))
+
+	for _, v := range list {
+		val := v.(map[string]interface{})
+		enabled := val["enabled"].(bool)
+		baseline[val["id"].(string)] = api.BulkUpdatePolicy{
+			PolicyID: val["id"].(string),
+			Enabled:  &enabled,
+			Severity: val["severity"].(string),
+		}
+	}
+
+	return baseline
+}
+
+func flattenManagedPoliciesBaseline(baseline map[string]api.BulkUpdatePolicy) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(baseline))
+	for id, policy := range baseline {
+		list = append(list, map[string]interface{}{
+			"id":       id,
+			"enabled":  *policy.Enabled,
+			"severity": policy.Severity,
+		})
+	}
+	return list
+}
+
+func sortBulkUpdatePolicies(policies api.BulkUpdatePolicies) {
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].PolicyID < policies[j].PolicyID
+	})
+}
+
 func getBulkUpdatePolicies(d *schema.ResourceData) (api.BulkUpdatePolicies, error) {
 	var policies api.BulkUpdatePolicies
 	list := d.Get("policy").(*schema.Set).List()
@@ -129,7 +424,7 @@ func getBulkUpdatePolicies(d *schema.ResourceData) (api.BulkUpdatePolicies, erro
 		policyID := val["id"].(string)
 		enabled := val["enabled"].(bool)
 
-		if !strings.HasPrefix(policyID, "lacework-global") {
+		if !strings.HasPrefix(policyID, laceworkGlobalPolicyPrefix) {
 			return nil, fmt.Errorf("Unable to update custom policy ID: %s", policyID)
 		}
 		if seen[policyID] {
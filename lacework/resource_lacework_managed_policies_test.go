@@ -0,0 +1,74 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriftedManagedPolicies(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLaceworkManagedPolicies().Schema, map[string]interface{}{
+		"authoritative": true,
+		"policy": []interface{}{map[string]interface{}{
+			"id": "lacework-global-1", "enabled": true, "severity": "high",
+		}},
+		"selector": []interface{}{map[string]interface{}{
+			"tags": []interface{}{"domain:AWS"},
+		}},
+	})
+	require.NoError(t, d.Set("baseline", []interface{}{
+		map[string]interface{}{"id": "lacework-global-1", "enabled": false, "severity": "low"},
+		map[string]interface{}{"id": "lacework-global-2", "enabled": true, "severity": "medium"},
+		map[string]interface{}{"id": "lacework-global-3", "enabled": true, "severity": "medium"},
+	}))
+
+	managed, err := getBulkUpdatePolicies(d)
+	require.NoError(t, err)
+
+	current := []api.Policy{
+		// managed policies never drift, they are configured
+		{PolicyID: "lacework-global-1", Enabled: true, Severity: "high", Tags: []string{"domain:AWS"}},
+		// selected policy changed outside of Terraform
+		{PolicyID: "lacework-global-2", Enabled: false, Severity: "Medium", Tags: []string{"domain:AWS"}},
+		// selected policy that matches its baseline
+		{PolicyID: "lacework-global-3", Enabled: true, Severity: "Medium", Tags: []string{"domain:AWS"}},
+		// policy not matching the selector
+		{PolicyID: "lacework-global-4", Enabled: false, Severity: "low", Tags: []string{"domain:GCP"}},
+	}
+
+	assert.Equal(t, []string{"lacework-global-2"}, driftedManagedPolicies(d, managed, current))
+}
+
+func TestGetManagedPoliciesSelectorDefaultsToGlobalPolicies(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLaceworkManagedPolicies().Schema, map[string]interface{}{
+		"selector": []interface{}{map[string]interface{}{}},
+	})
+
+	selector := getManagedPoliciesSelector(d)
+	require.NotNil(t, selector)
+	assert.Equal(t, laceworkGlobalPolicyPrefix, selector.idPrefix)
+	assert.False(t, selector.match(api.Policy{PolicyID: "custom-policy-1"}))
+}
+
+func TestRestoreBaselineBulkUpdatePolicies(t *testing.T) {
+	enabled, disabled := true, false
+	baseline := map[string]api.BulkUpdatePolicy{
+		"lacework-global-1": {PolicyID: "lacework-global-1", Enabled: &disabled, Severity: "low"},
+		"lacework-global-2": {PolicyID: "lacework-global-2", Enabled: &enabled, Severity: "medium"},
+	}
+	managed := api.BulkUpdatePolicies{
+		{PolicyID: "lacework-global-1", Enabled: &enabled, Severity: "high"},
+	}
+
+	// managed policies keep their configuration, the others are restored to their baseline
+	assert.Equal(t, api.BulkUpdatePolicies{
+		{PolicyID: "lacework-global-1", Enabled: &enabled, Severity: "high"},
+		{PolicyID: "lacework-global-2", Enabled: &enabled, Severity: "medium"},
+	}, restoreBaselineBulkUpdatePolicies(baseline, managed))
+
+	assert.Len(t, restoreBaselineBulkUpdatePolicies(baseline, nil), 2)
+	assert.Empty(t, restoreBaselineBulkUpdatePolicies(nil, nil))
+}
@@ -23,17 +23,16 @@ func resourceLaceworkPolicy() *schema.Resource {
 			StateContext: importLaceworkPolicy,
 		},
 
+		CustomizeDiff: policyInlineQueryCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"title": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "The title of the policy",
 			},
-			"query_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The id of the query",
-			},
+			"query_id": policyQueryIDSchema(),
+			"query":    policyInlineQuerySchema(),
 			"description": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -163,12 +162,17 @@ func resourceLaceworkPolicy() *schema.Resource {
 
 func resourceLaceworkPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
+	queryCreated, err := createPolicyInlineQuery(d, lacework)
+	if err != nil {
+		return err
+	}
+
 	policy := api.NewPolicy{
 		PolicyType:    d.Get("type").(string),
-		QueryID:       d.Get("query_id").(string),
+		QueryID:       getPolicyQueryID(d),
 		Title:         d.Get("title").(string),
 		Enabled:       d.Get("enabled").(bool),
 		Description:   d.Get("description").(string),
@@ -185,10 +189,17 @@ func resourceLaceworkPolicyCreate(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[INFO] Creating Policy with data:\n%+v\n", policy)
 	response, err := lacework.V2.Policy.Create(policy)
 	if err != nil {
+		// the query created for the policy would otherwise be left behind
+		if queryCreated {
+			if deleteErr := deletePolicyInlineQuery(lacework, policy.QueryID); deleteErr != nil {
+				log.Printf("[WARN] %s\n", deleteErr)
+			}
+		}
 		return err
 	}
 
 	d.SetId(response.Data.PolicyID)
+	d.Set("query_id", policy.QueryID)
 	d.Set("owner", response.Data.Owner)
 	d.Set("updated_time", response.Data.LastUpdateTime)
 	d.Set("updated_by", response.Data.LastUpdateUser)
@@ -200,7 +211,7 @@ func resourceLaceworkPolicyCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceLaceworkPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading Policy with guid %s\n", d.Id())
@@ -224,6 +235,10 @@ func resourceLaceworkPolicyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("updated_by", response.Data.LastUpdateUser)
 	d.Set("computed_tags", strings.Join(response.Data.Tags, ","))
 
+	if err := readPolicyInlineQuery(d, lacework, response.Data.QueryID); err != nil {
+		return err
+	}
+
 	alerting := make(map[string]interface{})
 	alerting["enabled"] = response.Data.AlertEnabled
 	alerting["profile"] = response.Data.AlertProfile
@@ -235,20 +250,25 @@ func resourceLaceworkPolicyRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceLaceworkPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	if d.HasChange("policy_id_suffix") {
 		return errors.New("unable to change ID of an existing policy")
 	}
 
+	previousQueryID, err := updatePolicyInlineQuery(d, lacework)
+	if err != nil {
+		return err
+	}
+
 	policyEnabled := d.Get("enabled").(bool)
 	alertingEnabled := d.Get("alerting.0.enabled").(bool)
 	policyLimit := d.Get("limit").(int)
 
 	policy := api.UpdatePolicy{
 		PolicyType:    d.Get("type").(string),
-		QueryID:       d.Get("query_id").(string),
+		QueryID:       getPolicyQueryID(d),
 		Title:         d.Get("title").(string),
 		Enabled:       &policyEnabled,
 		Description:   d.Get("description").(string),
@@ -268,7 +288,13 @@ func resourceLaceworkPolicyUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	// the previous query is deleted once the policy references the renamed query
+	if err := deletePolicyInlineQuery(lacework, previousQueryID); err != nil {
+		return err
+	}
+
 	d.SetId(response.Data.PolicyID)
+	d.Set("query_id", policy.QueryID)
 	d.Set("owner", response.Data.Owner)
 	d.Set("updated_time", response.Data.LastUpdateTime)
 	d.Set("updated_by", response.Data.LastUpdateUser)
@@ -279,7 +305,7 @@ func resourceLaceworkPolicyUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceLaceworkPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Policy with guid %s\n", d.Id())
 	_, err := lacework.V2.Policy.Delete(d.Id())
@@ -288,11 +314,15 @@ func resourceLaceworkPolicyDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[INFO] Deleted Policy with guid %s\n", d.Id())
+
+	if len(d.Get("query").([]interface{})) != 0 {
+		return deletePolicyInlineQuery(lacework, d.Get("query.0.id").(string))
+	}
 	return nil
 }
 
 func importLaceworkPolicy(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Policy with guid: %s\n", d.Id())
 
@@ -22,17 +22,16 @@ func resourceLaceworkPolicyCompliance() *schema.Resource {
 			StateContext: importLaceworkPolicyCompliance,
 		},
 
+		CustomizeDiff: policyInlineQueryCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"title": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "The title of the policy",
 			},
-			"query_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The id of the query",
-			},
+			"query_id": policyQueryIDSchema(),
+			"query":    policyInlineQuerySchema(),
 			"description": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -106,12 +105,17 @@ func resourceLaceworkPolicyCompliance() *schema.Resource {
 
 func resourceLaceworkPolicyComplianceCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
+	queryCreated, err := createPolicyInlineQuery(d, lacework)
+	if err != nil {
+		return err
+	}
+
 	policy := api.NewPolicy{
 		PolicyType:   api.PolicyTypeCompliance.String(),
-		QueryID:      d.Get("query_id").(string),
+		QueryID:      getPolicyQueryID(d),
 		Title:        d.Get("title").(string),
 		Enabled:      d.Get("enabled").(bool),
 		Description:  d.Get("description").(string),
@@ -125,10 +129,17 @@ func resourceLaceworkPolicyComplianceCreate(d *schema.ResourceData, meta interfa
 	log.Printf("[INFO] Creating Policy with data:\n%+v\n", policy)
 	response, err := lacework.V2.Policy.Create(policy)
 	if err != nil {
+		// the query created for the policy would otherwise be left behind
+		if queryCreated {
+			if deleteErr := deletePolicyInlineQuery(lacework, policy.QueryID); deleteErr != nil {
+				log.Printf("[WARN] %s\n", deleteErr)
+			}
+		}
 		return err
 	}
 
 	d.SetId(response.Data.PolicyID)
+	d.Set("query_id", policy.QueryID)
 	d.Set("owner", response.Data.Owner)
 	d.Set("updated_time", response.Data.LastUpdateTime)
 	d.Set("updated_by", response.Data.LastUpdateUser)
@@ -140,7 +151,7 @@ func resourceLaceworkPolicyComplianceCreate(d *schema.ResourceData, meta interfa
 
 func resourceLaceworkPolicyComplianceRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading Policy with guid %s\n", d.Id())
@@ -163,24 +174,33 @@ func resourceLaceworkPolicyComplianceRead(d *schema.ResourceData, meta interface
 	d.Set("updated_by", response.Data.LastUpdateUser)
 	d.Set("computed_tags", strings.Join(response.Data.Tags, ","))
 
+	if err := readPolicyInlineQuery(d, lacework, response.Data.QueryID); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Read Policy with guid %s\n", response.Data.PolicyID)
 	return nil
 }
 
 func resourceLaceworkPolicyComplianceUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	if d.HasChange("policy_id_suffix") {
 		return errors.New("unable to change ID of an existing policy")
 	}
 
+	previousQueryID, err := updatePolicyInlineQuery(d, lacework)
+	if err != nil {
+		return err
+	}
+
 	policyEnabled := d.Get("enabled").(bool)
 
 	policy := api.UpdatePolicy{
 		PolicyType:  api.PolicyTypeCompliance.String(),
-		QueryID:     d.Get("query_id").(string),
+		QueryID:     getPolicyQueryID(d),
 		Title:       d.Get("title").(string),
 		Enabled:     &policyEnabled,
 		Description: d.Get("description").(string),
@@ -196,7 +216,13 @@ func resourceLaceworkPolicyComplianceUpdate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	// the previous query is deleted once the policy references the renamed query
+	if err := deletePolicyInlineQuery(lacework, previousQueryID); err != nil {
+		return err
+	}
+
 	d.SetId(response.Data.PolicyID)
+	d.Set("query_id", policy.QueryID)
 	d.Set("owner", response.Data.Owner)
 	d.Set("updated_time", response.Data.LastUpdateTime)
 	d.Set("updated_by", response.Data.LastUpdateUser)
@@ -207,7 +233,7 @@ func resourceLaceworkPolicyComplianceUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceLaceworkPolicyComplianceDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Policy with guid %s\n", d.Id())
 	_, err := lacework.V2.Policy.Delete(d.Id())
@@ -216,11 +242,15 @@ func resourceLaceworkPolicyComplianceDelete(d *schema.ResourceData, meta interfa
 	}
 
 	log.Printf("[INFO] Deleted Policy with guid %s\n", d.Id())
+
+	if len(d.Get("query").([]interface{})) != 0 {
+		return deletePolicyInlineQuery(lacework, d.Get("query.0.id").(string))
+	}
 	return nil
 }
 
 func importLaceworkPolicyCompliance(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Policy with guid: %s\n", d.Id())
 
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/lacework/go-sdk/v2/api"
 	"github.com/pkg/errors"
@@ -23,6 +25,8 @@ func resourceLaceworkPolicyException() *schema.Resource {
 			StateContext: importLaceworkPolicyException,
 		},
 
+		CustomizeDiff: resourceLaceworkPolicyExceptionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
 				Type:        schema.TypeString,
@@ -123,7 +127,7 @@ func resourceLaceworkPolicyException() *schema.Resource {
 
 func resourceLaceworkPolicyExceptionCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		policyID = d.Get("policy_id").(string)
 	)
 
@@ -152,7 +156,7 @@ func resourceLaceworkPolicyExceptionCreate(d *schema.ResourceData, meta interfac
 
 func resourceLaceworkPolicyExceptionRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		response api.PolicyExceptionResponse
 	)
 
@@ -174,7 +178,7 @@ func resourceLaceworkPolicyExceptionRead(d *schema.ResourceData, meta interface{
 
 func resourceLaceworkPolicyExceptionUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		policyID = d.Get("policy_id").(string)
 	)
 
@@ -204,7 +208,7 @@ func resourceLaceworkPolicyExceptionUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceLaceworkPolicyExceptionDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Policy with guid %s\n", d.Id())
 	err := lacework.V2.Policy.Exceptions.Delete(d.Get("policy_id").(string), d.Id())
@@ -218,7 +222,13 @@ func resourceLaceworkPolicyExceptionDelete(d *schema.ResourceData, meta interfac
 
 func importLaceworkPolicyException(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	var response api.PolicyExceptionResponse
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
+
+	// policy exceptions are imported with '<policy_id>:<exception_id>'
+	if policyID, exceptionID, found := strings.Cut(d.Id(), ":"); found {
+		d.Set("policy_id", policyID)
+		d.SetId(exceptionID)
+	}
 
 	log.Printf("[INFO] Importing Lacework Policy Exception with guid: %s\n", d.Id())
 
@@ -233,6 +243,106 @@ func importLaceworkPolicyException(_ context.Context, d *schema.ResourceData, me
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceLaceworkPolicyExceptionCustomizeDiff validates the constraints against the
+// exception configuration of the policy, invalid constraints fail the plan instead of the apply
+func resourceLaceworkPolicyExceptionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	m, ok := meta.(*providerMeta)
+	if !ok || m == nil {
+		return nil
+	}
+	lacework := m.client
+
+	if d.Id() != "" && !d.HasChanges("policy_id", "constraint") {
+		return nil
+	}
+
+	// the constraints can't be validated until every value is known
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.GetAttr("policy_id").IsKnown() || !rawConfig.GetAttr("constraint").IsWhollyKnown() {
+		return nil
+	}
+
+	policyID := d.Get("policy_id").(string)
+	log.Printf("[INFO] Reading the exception configuration of Policy %s\n", policyID)
+	response, err := lacework.V2.Policy.Get(policyID)
+	if err != nil {
+		return cty.GetAttrPath("policy_id").NewErrorf("unable to read policy '%s': %s", policyID, err)
+	}
+
+	var configuration []api.PolicyExceptionConfigurationConstraints
+	for _, constraints := range response.Data.ExceptionConfiguration {
+		configuration = append(configuration, constraints...)
+	}
+
+	err = validatePolicyExceptionConstraints(policyID, configuration, d.Get("constraint").(*schema.Set).List())
+	if err != nil {
+		return cty.GetAttrPath("constraint").NewError(err)
+	}
+	return nil
+}
+
+// validatePolicyExceptionConstraints verifies that the key of every constraint is accepted by
+// the policy, and that the values of the constraint match the data type of the key
+func validatePolicyExceptionConstraints(
+	policyID string,
+	configuration []api.PolicyExceptionConfigurationConstraints,
+	constraints []interface{},
+) error {
+	// policies without exception configuration are validated by the Lacework API
+	if len(configuration) == 0 {
+		return nil
+	}
+
+	var (
+		allowedKeys = make([]string, 0, len(configuration))
+		fields      = make(map[string]api.PolicyExceptionConfigurationConstraints, len(configuration))
+	)
+	for _, field := range configuration {
+		allowedKeys = append(allowedKeys, field.FieldKey)
+		fields[field.FieldKey] = field
+	}
+	sort.Strings(allowedKeys)
+
+	for _, c := range constraints {
+		constraint := c.(map[string]interface{})
+		fieldKey := constraint["field_key"].(string)
+
+		field, found := fields[fieldKey]
+		if !found {
+			return fmt.Errorf("field_key '%s' is not valid for policy '%s', allowed keys are: %s",
+				fieldKey, policyID, strings.Join(allowedKeys, ", "))
+		}
+
+		var (
+			values    = len(constraint["field_values"].([]interface{}))
+			valuesMap = constraint["field_values_map"].(*schema.Set).Len() +
+				constraint["field_value_map"].(*schema.Set).Len()
+		)
+
+		if isPolicyExceptionMapDataType(field.DataType) {
+			if values != 0 || valuesMap == 0 {
+				return fmt.Errorf("field_key '%s' of policy '%s' has the data type '%s', "+
+					"its values must be set with field_values_map", fieldKey, policyID, field.DataType)
+			}
+			values = valuesMap
+		} else if valuesMap != 0 || values == 0 {
+			return fmt.Errorf("field_key '%s' of policy '%s' has the data type '%s', "+
+				"its values must be set with field_values", fieldKey, policyID, field.DataType)
+		}
+
+		if !field.MultiValue && values > 1 {
+			return fmt.Errorf("field_key '%s' of policy '%s' accepts a single value, %d values are set",
+				fieldKey, policyID, values)
+		}
+	}
+	return nil
+}
+
+// isPolicyExceptionMapDataType returns true when the values of a constraint are key value pairs
+func isPolicyExceptionMapDataType(dataType string) bool {
+	return strings.EqualFold(dataType, "KVTagPair")
+}
+
 func castSchemaSetToConstraintArray(d *schema.ResourceData, attr string) (constraints []api.PolicyExceptionConstraint, err error) {
 	var (
 		list []any
@@ -0,0 +1,79 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePolicyExceptionConstraints(t *testing.T) {
+	configuration := []api.PolicyExceptionConfigurationConstraints{
+		{FieldKey: "accountIds", DataType: "String", MultiValue: true},
+		{FieldKey: "regionNames", DataType: "String", MultiValue: false},
+		{FieldKey: "resourceTags", DataType: "KVTagPair", MultiValue: true},
+	}
+
+	constraints := func(constraint map[string]interface{}) []interface{} {
+		d := schema.TestResourceDataRaw(t, resourceLaceworkPolicyException().Schema, map[string]interface{}{
+			"policy_id":   "lacework-global-31",
+			"description": "test",
+			"constraint":  []interface{}{constraint},
+		})
+		return d.Get("constraint").(*schema.Set).List()
+	}
+
+	cases := []struct {
+		name       string
+		constraint map[string]interface{}
+		err        string
+	}{
+		{
+			name:       "string values",
+			constraint: map[string]interface{}{"field_key": "accountIds", "field_values": []interface{}{"1", "2"}},
+		},
+		{
+			name: "key value pairs",
+			constraint: map[string]interface{}{"field_key": "resourceTags", "field_values_map": []interface{}{
+				map[string]interface{}{"key": "env", "value": []interface{}{"dev"}},
+			}},
+		},
+		{
+			name:       "unknown key",
+			constraint: map[string]interface{}{"field_key": "accountId", "field_values": []interface{}{"1"}},
+			err: "field_key 'accountId' is not valid for policy 'lacework-global-31', " +
+				"allowed keys are: accountIds, regionNames, resourceTags",
+		},
+		{
+			name:       "map values for a string key",
+			constraint: map[string]interface{}{"field_key": "accountIds", "field_value_map": []interface{}{map[string]interface{}{"key": "a", "value": "b"}}},
+			err:        "its values must be set with field_values",
+		},
+		{
+			name:       "string values for a key value key",
+			constraint: map[string]interface{}{"field_key": "resourceTags", "field_values": []interface{}{"env:dev"}},
+			err:        "its values must be set with field_values_map",
+		},
+		{
+			name:       "multiple values for a single value key",
+			constraint: map[string]interface{}{"field_key": "regionNames", "field_values": []interface{}{"us-east-1", "us-west-2"}},
+			err:        "accepts a single value, 2 values are set",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validatePolicyExceptionConstraints("lacework-global-31", configuration, constraints(c.constraint))
+			if c.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), c.err)
+			}
+		})
+	}
+
+	// policies without exception configuration are not validated
+	assert.NoError(t, validatePolicyExceptionConstraints("custom-1", nil,
+		constraints(map[string]interface{}{"field_key": "anything"})))
+}
@@ -0,0 +1,181 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// policyInlineQuerySchema is the schema of the query block of the policy resources, the
+// query of the block is created, updated and deleted together with the policy
+func policyInlineQuerySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"query", "query_id"},
+		Description:  "The query of the policy, managed together with the policy",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The id of the query",
+				},
+				"text": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The query string",
+				},
+			},
+		},
+	}
+}
+
+// policyQueryIDSchema is the schema of the query_id attribute of the policy resources,
+// it is computed from the query block when the query is managed by the policy
+func policyQueryIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"query", "query_id"},
+		Description:  "The id of the query",
+	}
+}
+
+// policyInlineQueryCustomizeDiff keeps the query_id attribute in step with the id of the
+// query block, resources that reference the query id see the new id during the plan
+func policyInlineQueryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if len(d.Get("query").([]interface{})) == 0 || !d.NewValueKnown("query.0.id") {
+		return nil
+	}
+
+	queryID := d.Get("query.0.id").(string)
+	if d.Get("query_id").(string) != queryID {
+		return d.SetNew("query_id", queryID)
+	}
+	return nil
+}
+
+// getPolicyQueryID returns the id of the query of the policy, either from
+// the query block or from the query_id attribute
+func getPolicyQueryID(d *schema.ResourceData) string {
+	if len(d.Get("query").([]interface{})) != 0 {
+		return d.Get("query.0.id").(string)
+	}
+	return d.Get("query_id").(string)
+}
+
+// createPolicyInlineQuery creates the query of the query block, it fails when a query with
+// the same id already exists since the query would be deleted together with the policy
+func createPolicyInlineQuery(d *schema.ResourceData, lacework *api.Client) (created bool, err error) {
+	if len(d.Get("query").([]interface{})) == 0 {
+		return false, nil
+	}
+
+	var (
+		queryID   = d.Get("query.0.id").(string)
+		queryText = d.Get("query.0.text").(string)
+	)
+
+	if _, err := lacework.V2.Query.Get(queryID); err == nil {
+		return false, fmt.Errorf(
+			"query '%s' already exists, reference it with query_id or use a new id in the query block", queryID,
+		)
+	} else if !notFound(err) {
+		return false, err
+	}
+
+	log.Printf("[INFO] Creating Query with guid %s\n", queryID)
+	if _, err := lacework.V2.Query.Create(api.NewQuery{QueryID: queryID, QueryText: queryText}); err != nil {
+		return false, err
+	}
+	log.Printf("[INFO] Created Query with guid %s\n", queryID)
+	return true, nil
+}
+
+// updatePolicyInlineQuery updates the query of the query block and returns the id of the
+// previous query managed by the block, to be deleted once the policy stops referencing it.
+// A query that already exists is only taken over when the policy already referenced it
+// with query_id, that is when moving a query from a lacework_query resource to the block
+func updatePolicyInlineQuery(d *schema.ResourceData, lacework *api.Client) (previousQueryID string, err error) {
+	if !d.HasChange("query") {
+		return "", nil
+	}
+
+	var oldQueryID string
+	old, _ := d.GetChange("query")
+	if oldQuery := old.([]interface{}); len(oldQuery) != 0 && oldQuery[0] != nil {
+		oldQueryID = oldQuery[0].(map[string]interface{})["id"].(string)
+	}
+
+	// the query block was replaced by query_id, the query of the block is deleted
+	// unless the policy keeps referencing it
+	if len(d.Get("query").([]interface{})) == 0 {
+		if oldQueryID != d.Get("query_id").(string) {
+			return oldQueryID, nil
+		}
+		return "", nil
+	}
+
+	var (
+		queryID   = d.Get("query.0.id").(string)
+		queryText = d.Get("query.0.text").(string)
+	)
+	oldReferencedID, _ := d.GetChange("query_id")
+	if queryID == oldQueryID || (oldQueryID == "" && queryID == oldReferencedID.(string)) {
+		log.Printf("[INFO] Updating Query with guid %s\n", queryID)
+		if _, err := lacework.V2.Query.Update(queryID, api.UpdateQuery{QueryText: queryText}); err != nil {
+			return "", err
+		}
+		log.Printf("[INFO] Updated Query with guid %s\n", queryID)
+		return "", nil
+	}
+
+	if _, err := createPolicyInlineQuery(d, lacework); err != nil {
+		return "", err
+	}
+	return oldQueryID, nil
+}
+
+// deletePolicyInlineQuery deletes a query that was managed by the query block of a policy
+func deletePolicyInlineQuery(lacework *api.Client, queryID string) error {
+	if queryID == "" {
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting Query with guid %s\n", queryID)
+	if _, err := lacework.V2.Query.Delete(queryID); err != nil && !notFound(err) {
+		return fmt.Errorf("unable to delete query '%s': %s", queryID, err)
+	}
+	log.Printf("[INFO] Deleted Query with guid %s\n", queryID)
+	return nil
+}
+
+// readPolicyInlineQuery reads the query of the query block, the block is only
+// read when it is configured to stay compatible with the lacework_query resource
+func readPolicyInlineQuery(d *schema.ResourceData, lacework *api.Client, queryID string) error {
+	if len(d.Get("query").([]interface{})) == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Reading Query with guid %s\n", queryID)
+	response, err := lacework.V2.Query.Get(queryID)
+	if err != nil {
+		if notFound(err) {
+			d.Set("query", nil)
+			return nil
+		}
+		return err
+	}
+
+	d.Set("query", []map[string]interface{}{{
+		"id":   response.Data.QueryID,
+		"text": response.Data.QueryText,
+	}})
+	return nil
+}
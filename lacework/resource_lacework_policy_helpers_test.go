@@ -0,0 +1,66 @@
+package lacework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPolicyQueryID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceLaceworkPolicy().Schema, map[string]interface{}{
+		"query_id": "MyQuery",
+	})
+	assert.Equal(t, "MyQuery", getPolicyQueryID(d))
+
+	d = schema.TestResourceDataRaw(t, resourceLaceworkPolicyCompliance().Schema, map[string]interface{}{
+		"query": []interface{}{map[string]interface{}{"id": "MyInlineQuery", "text": "{}"}},
+	})
+	assert.Equal(t, "MyInlineQuery", getPolicyQueryID(d))
+}
+
+func TestUpdatePolicyInlineQueryReplacedByQueryID(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "my-policy",
+		Attributes: map[string]string{
+			"id":           "my-policy",
+			"query.#":      "1",
+			"query.0.id":   "MyInlineQuery",
+			"query.0.text": "{}",
+			"query_id":     "MyInlineQuery",
+		},
+	}
+
+	for name, c := range map[string]struct {
+		queryID  string
+		previous string
+	}{
+		"new query":  {"MyOtherQuery", "MyInlineQuery"},
+		"same query": {"MyInlineQuery", ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource := resourceLaceworkPolicy()
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"title":    "My Policy",
+				"type":     "Violation",
+				"severity": "high",
+				"query_id": c.queryID,
+				"alerting": []interface{}{map[string]interface{}{"enabled": true}},
+			})
+			diff, err := resource.Diff(context.Background(), state, config, nil)
+			require.NoError(t, err)
+
+			d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+			require.NoError(t, err)
+
+			// the API is not called when the query block is removed
+			previous, err := updatePolicyInlineQuery(d, nil)
+			if assert.NoError(t, err) {
+				assert.Equal(t, c.previous, previous)
+			}
+		})
+	}
+}
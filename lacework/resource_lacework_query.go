@@ -2,9 +2,11 @@ package lacework
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/lacework/go-sdk/v2/api"
 	"github.com/pkg/errors"
@@ -21,6 +23,8 @@ func resourceLaceworkQuery() *schema.Resource {
 			StateContext: importLaceworkQuery,
 		},
 
+		CustomizeDiff: resourceLaceworkQueryCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"query_id": {
 				Type:        schema.TypeString,
@@ -45,8 +49,9 @@ func resourceLaceworkQuery() *schema.Resource {
 				Computed: true,
 			},
 			"result_schema": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON encoded schema of the query results",
 			},
 		},
 	}
@@ -54,7 +59,7 @@ func resourceLaceworkQuery() *schema.Resource {
 
 func resourceLaceworkQueryCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	query := api.NewQuery{
@@ -72,7 +77,7 @@ func resourceLaceworkQueryCreate(d *schema.ResourceData, meta interface{}) error
 	d.Set("owner", response.Data.Owner)
 	d.Set("updated_time", response.Data.LastUpdateTime)
 	d.Set("updated_by", response.Data.LastUpdateUser)
-	d.Set("result_schema", response.Data.ResultSchema)
+	d.Set("result_schema", flattenQueryResultSchema(response.Data.ResultSchema))
 
 	log.Printf("[INFO] Created Query with guid %s\n", response.Data.QueryID)
 	return nil
@@ -80,7 +85,7 @@ func resourceLaceworkQueryCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceLaceworkQueryRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading Query with guid %s\n", d.Id())
@@ -94,7 +99,7 @@ func resourceLaceworkQueryRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("owner", response.Data.Owner)
 	d.Set("updated_time", response.Data.LastUpdateTime)
 	d.Set("updated_by", response.Data.LastUpdateUser)
-	d.Set("result_schema", response.Data.ResultSchema)
+	d.Set("result_schema", flattenQueryResultSchema(response.Data.ResultSchema))
 
 	log.Printf("[INFO] Read Query with guid %s\n", response.Data.QueryID)
 	return nil
@@ -102,7 +107,7 @@ func resourceLaceworkQueryRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceLaceworkQueryUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	if d.HasChange("query_id") {
@@ -122,14 +127,14 @@ func resourceLaceworkQueryUpdate(d *schema.ResourceData, meta interface{}) error
 	d.Set("owner", response.Data.Owner)
 	d.Set("updated_time", response.Data.LastUpdateTime)
 	d.Set("updated_by", response.Data.LastUpdateUser)
-	d.Set("result_schema", response.Data.ResultSchema)
+	d.Set("result_schema", flattenQueryResultSchema(response.Data.ResultSchema))
 
 	log.Printf("[INFO] Updated Query with guid %s\n", response.Data.QueryID)
 	return nil
 }
 
 func resourceLaceworkQueryDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Query with guid %s\n", d.Id())
 	_, err := lacework.V2.Query.Delete(d.Id())
@@ -141,8 +146,38 @@ func resourceLaceworkQueryDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// resourceLaceworkQueryCustomizeDiff validates new and updated queries during the plan,
+// invalid queries are reported on the query attribute instead of failing the apply, and
+// the result schema of valid queries is known before the apply
+func resourceLaceworkQueryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	m, ok := meta.(*providerMeta)
+	if !ok || m == nil {
+		return nil
+	}
+	lacework := m.client
+
+	if d.Id() != "" && !d.HasChange("query") {
+		return nil
+	}
+
+	// the query is unknown when it depends on resources that are not yet created
+	if !d.NewValueKnown("query") {
+		return d.SetNewComputed("result_schema")
+	}
+
+	query := api.ValidateQuery{QueryText: d.Get("query").(string)}
+
+	log.Printf("[INFO] Validating Query with data:\n%+v\n", query)
+	response, err := lacework.V2.Query.Validate(query)
+	if err != nil {
+		return cty.GetAttrPath("query").NewErrorf("invalid LQL query: %s", err)
+	}
+
+	return d.SetNew("result_schema", flattenQueryResultSchema(response.Data.ResultSchema))
+}
+
 func importLaceworkQuery(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Query with guid: %s\n", d.Id())
 
@@ -156,3 +191,17 @@ func importLaceworkQuery(_ context.Context, d *schema.ResourceData, meta interfa
 	log.Printf("[INFO] Query found with guid: %s\n", response.Data.QueryID)
 	return []*schema.ResourceData{d}, nil
 }
+
+// flattenQueryResultSchema encodes the result schema of a query as JSON
+func flattenQueryResultSchema(resultSchema []map[string]interface{}) string {
+	if len(resultSchema) == 0 {
+		return ""
+	}
+
+	bytes, err := json.Marshal(resultSchema)
+	if err != nil {
+		log.Printf("[WARN] Unable to encode the result schema of the query: %s\n", err)
+		return ""
+	}
+	return string(bytes)
+}
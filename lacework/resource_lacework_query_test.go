@@ -0,0 +1,15 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenQueryResultSchema(t *testing.T) {
+	assert.Equal(t, "", flattenQueryResultSchema(nil))
+	assert.Equal(t,
+		`[{"dataType":"String","name":"INSERT_ID"}]`,
+		flattenQueryResultSchema([]map[string]interface{}{{"name": "INSERT_ID", "dataType": "String"}}),
+	)
+}
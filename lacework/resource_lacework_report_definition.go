@@ -0,0 +1,310 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+func resourceLaceworkReportDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLaceworkReportDefinitionCreate,
+		Read:   resourceLaceworkReportDefinitionRead,
+		Update: resourceLaceworkReportDefinitionUpdate,
+		Delete: resourceLaceworkReportDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importLaceworkReportDefinition,
+		},
+
+		CustomizeDiff: resourceLaceworkReportDefinitionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the report definition",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the report displayed in the report",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  api.ReportDefinitionTypeCompliance.String(),
+				Description: fmt.Sprintf("The type of the report definition. Valid types are: %s",
+					api.ReportDefinitionTypeCompliance.String()),
+				ValidateFunc: validation.StringInSlice([]string{api.ReportDefinitionTypeCompliance.String()}, false),
+			},
+			"sub_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: fmt.Sprintf("The sub-type of the report definition. Valid sub-types are: %s",
+					strings.Join(api.ReportDefinitionSubtypes, ", ")),
+				ValidateFunc: validation.StringInSlice(api.ReportDefinitionSubtypes, false),
+			},
+			"section": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The sections of the report definition",
+				ConflictsWith: []string{"revert_to_version"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The title of the section",
+						},
+						"category": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The category of the section",
+						},
+						"policies": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "The ids of the policies in the section",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"revert_to_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Revert the report definition to a previous version, the sections of " +
+					"the report definition are then read from the reverted version",
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"section"},
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The current version of the report definition",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All versions of the report definition",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLaceworkReportDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	sections := getReportDefinitionSections(d)
+	if len(sections) == 0 {
+		return fmt.Errorf("at least one section is required to create a report definition")
+	}
+
+	reportDefinition := api.NewReportDefinition(api.ReportDefinitionConfig{
+		ReportName:    d.Get("name").(string),
+		DisplayName:   d.Get("display_name").(string),
+		ReportType:    d.Get("type").(string),
+		SubReportType: d.Get("sub_type").(string),
+		Sections:      sections,
+	})
+
+	log.Printf("[INFO] Creating Report Definition with data:\n%+v\n", reportDefinition)
+	response, err := lacework.V2.ReportDefinitions.Create(reportDefinition)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(response.Data.ReportDefinitionGuid)
+	log.Printf("[INFO] Created Report Definition with guid %s\n", response.Data.ReportDefinitionGuid)
+
+	return resourceLaceworkReportDefinitionRead(d, meta)
+}
+
+func resourceLaceworkReportDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Reading Report Definition with guid %s\n", d.Id())
+	response, err := lacework.V2.ReportDefinitions.Get(d.Id())
+	if err != nil {
+		return resourceNotFound(d, err)
+	}
+
+	versions, err := lacework.V2.ReportDefinitions.GetVersions(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", response.Data.ReportName)
+	d.Set("display_name", response.Data.DisplayName)
+	d.Set("type", response.Data.ReportType)
+	d.Set("sub_type", response.Data.SubReportType)
+	d.Set("section", flattenReportDefinitionSections(response.Data.ReportDefinitionDetails.Sections))
+	d.Set("version", response.Data.Version)
+	d.Set("versions", reportDefinitionVersions(versions.Data))
+	d.Set("created_by", response.Data.CreatedBy)
+	if response.Data.CreatedTime != nil {
+		d.Set("created_time", response.Data.CreatedTime.UTC().String())
+	}
+
+	log.Printf("[INFO] Read Report Definition with guid %s and version %d\n",
+		response.Data.ReportDefinitionGuid, response.Data.Version)
+	return nil
+}
+
+func resourceLaceworkReportDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	if version, ok := d.GetOk("revert_to_version"); ok && d.HasChange("revert_to_version") {
+		if !ContainsInt(castIntSlice(d.Get("versions").([]interface{})), version.(int)) {
+			return fmt.Errorf("unable to revert report definition to version %d, available versions are: %v",
+				version.(int), d.Get("versions"))
+		}
+
+		log.Printf("[INFO] Reverting Report Definition with guid %s to version %d\n", d.Id(), version.(int))
+		if _, err := lacework.V2.ReportDefinitions.Revert(d.Id(), version.(int)); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Reverted Report Definition with guid %s to version %d\n", d.Id(), version.(int))
+	}
+
+	if d.HasChanges("name", "display_name", "section") {
+		update := api.NewReportDefinitionUpdate(api.ReportDefinitionConfig{
+			ReportName:  d.Get("name").(string),
+			DisplayName: d.Get("display_name").(string),
+			Sections:    getReportDefinitionSections(d),
+		})
+
+		// when the report definition was reverted, the sections of the reverted
+		// version are kept and only the names are updated
+		if _, ok := d.GetOk("revert_to_version"); ok {
+			update.ReportDefinitionDetails = nil
+		}
+
+		log.Printf("[INFO] Updating Report Definition with data:\n%+v\n", update)
+		if _, err := lacework.V2.ReportDefinitions.Update(d.Id(), update); err != nil {
+			return err
+		}
+		log.Printf("[INFO] Updated Report Definition with guid %s\n", d.Id())
+	}
+
+	return resourceLaceworkReportDefinitionRead(d, meta)
+}
+
+func resourceLaceworkReportDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Deleting Report Definition with guid %s\n", d.Id())
+	err := lacework.V2.ReportDefinitions.Delete(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleted Report Definition with guid %s\n", d.Id())
+	return nil
+}
+
+// resourceLaceworkReportDefinitionCustomizeDiff marks the sections and the version as
+// unknown when the report definition is reverted or updated, both are set by the server
+func resourceLaceworkReportDefinitionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if _, ok := d.GetOk("revert_to_version"); ok && d.HasChange("revert_to_version") {
+		if err := d.SetNewComputed("section"); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("name", "display_name", "section", "revert_to_version") {
+		if err := d.SetNewComputed("version"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("versions")
+	}
+	return nil
+}
+
+func importLaceworkReportDefinition(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Importing Lacework Report Definition with guid: %s\n", d.Id())
+
+	response, err := lacework.V2.ReportDefinitions.Get(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to import Lacework resource. Report Definition with guid '%s' was not found",
+			d.Id(),
+		)
+	}
+
+	if !response.Data.IsCustom() {
+		return nil, fmt.Errorf(
+			"unable to import Lacework resource. Report Definition with guid '%s' is not a custom report definition",
+			d.Id(),
+		)
+	}
+
+	log.Printf("[INFO] Report Definition found with guid: %s\n", response.Data.ReportDefinitionGuid)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getReportDefinitionSections(d *schema.ResourceData) []api.ReportDefinitionSection {
+	list := d.Get("section").([]interface{})
+	sections := make([]api.ReportDefinitionSection, 0, len(list))
+
+	for _, v := range list {
+		val := v.(map[string]interface{})
+		sections = append(sections, api.ReportDefinitionSection{
+			Title:    val["title"].(string),
+			Category: val["category"].(string),
+			Policies: castStringSlice(val["policies"].([]interface{})),
+		})
+	}
+	return sections
+}
+
+func flattenReportDefinitionSections(sections []api.ReportDefinitionSection) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(sections))
+	for _, section := range sections {
+		list = append(list, map[string]interface{}{
+			"title":    section.Title,
+			"category": section.Category,
+			"policies": section.Policies,
+		})
+	}
+	return list
+}
+
+func reportDefinitionVersions(definitions []api.ReportDefinition) []int {
+	versions := make([]int, 0, len(definitions))
+	for _, definition := range definitions {
+		versions = append(versions, definition.Version)
+	}
+	sort.Ints(versions)
+	return versions
+}
@@ -0,0 +1,131 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportDefinitionSections(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      []interface{}
+		sections []api.ReportDefinitionSection
+	}{
+		{
+			name:     "no sections",
+			raw:      []interface{}{},
+			sections: []api.ReportDefinitionSection{},
+		},
+		{
+			name: "single section without category",
+			raw: []interface{}{
+				map[string]interface{}{
+					"title":    "Identity",
+					"policies": []interface{}{"lacework-global-31"},
+				},
+			},
+			sections: []api.ReportDefinitionSection{
+				{Title: "Identity", Category: "", Policies: []string{"lacework-global-31"}},
+			},
+		},
+		{
+			name: "sections and policies keep their order",
+			raw: []interface{}{
+				map[string]interface{}{
+					"title":    "Storage",
+					"category": "S3",
+					"policies": []interface{}{"lacework-global-72", "lacework-global-39", "lacework-global-46"},
+				},
+				map[string]interface{}{
+					"title":    "Logging",
+					"category": "CloudTrail",
+					"policies": []interface{}{"lacework-global-53", "lacework-global-50"},
+				},
+			},
+			sections: []api.ReportDefinitionSection{
+				{
+					Title:    "Storage",
+					Category: "S3",
+					Policies: []string{"lacework-global-72", "lacework-global-39", "lacework-global-46"},
+				},
+				{
+					Title:    "Logging",
+					Category: "CloudTrail",
+					Policies: []string{"lacework-global-53", "lacework-global-50"},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceLaceworkReportDefinition().Schema,
+				map[string]interface{}{"section": c.raw})
+
+			sections := getReportDefinitionSections(d)
+			assert.Equal(t, c.sections, sections)
+
+			// the flattened sections are read back as the same sections
+			flattened := flattenReportDefinitionSections(sections)
+			assert.Len(t, flattened, len(c.sections))
+			require.NoError(t, d.Set("section", flattened))
+			assert.Equal(t, c.sections, getReportDefinitionSections(d))
+		})
+	}
+}
+
+func TestFlattenReportDefinitionSections(t *testing.T) {
+	cases := []struct {
+		name     string
+		sections []api.ReportDefinitionSection
+		expected []map[string]interface{}
+	}{
+		{
+			name:     "no sections",
+			sections: nil,
+			expected: []map[string]interface{}{},
+		},
+		{
+			name: "sections",
+			sections: []api.ReportDefinitionSection{
+				{Title: "Storage", Category: "S3", Policies: []string{"lacework-global-72", "lacework-global-39"}},
+				{Title: "Identity", Policies: []string{"lacework-global-31"}},
+			},
+			expected: []map[string]interface{}{
+				{"title": "Storage", "category": "S3", "policies": []string{"lacework-global-72", "lacework-global-39"}},
+				{"title": "Identity", "category": "", "policies": []string{"lacework-global-31"}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, flattenReportDefinitionSections(c.sections))
+		})
+	}
+}
+
+func TestReportDefinitionVersions(t *testing.T) {
+	cases := []struct {
+		name        string
+		definitions []api.ReportDefinition
+		expected    []int
+	}{
+		{name: "no versions", definitions: nil, expected: []int{}},
+		{
+			name:        "sorted versions",
+			definitions: []api.ReportDefinition{{Version: 3}, {Version: 1}, {Version: 2}},
+			expected:    []int{1, 2, 3},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, reportDefinitionVersions(c.definitions))
+		})
+	}
+}
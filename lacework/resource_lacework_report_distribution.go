@@ -0,0 +1,308 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+var reportDistributionSeverities = []string{"Critical", "High", "Medium", "Low", "Info"}
+
+func resourceLaceworkReportDistribution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLaceworkReportDistributionCreate,
+		Read:   resourceLaceworkReportDistributionRead,
+		Update: resourceLaceworkReportDistributionUpdate,
+		Delete: resourceLaceworkReportDistributionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importLaceworkReportDistribution,
+		},
+
+		CustomizeDiff: resourceLaceworkReportDistributionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the report distribution",
+			},
+			"report_definition_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The guid of the report definition to distribute",
+			},
+			"frequency": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: fmt.Sprintf("The frequency of the report distribution. Valid frequencies are: %s",
+					strings.Join(sortedReportDistributionFrequencies(), ", ")),
+				ValidateFunc: validation.StringInSlice(api.ReportDistributionFrequencies(), false),
+			},
+			"alert_channels": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The alert channels that receive the report",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: fmt.Sprintf("The scope of the report distribution. Valid scopes are: %s",
+					strings.Join(sortedReportDistributionScopes(), ", ")),
+				ValidateFunc: validation.StringInSlice(api.ReportDistributionScopes(), false),
+			},
+			"resource_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The guids of the resource groups in scope of the report",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"integrations": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The cloud account integrations in scope of the report",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The AWS account id",
+						},
+						"organization_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The GCP organization id",
+						},
+						"project_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The GCP project id",
+						},
+						"tenant_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The Azure tenant id",
+						},
+						"subscription_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The Azure subscription id",
+						},
+					},
+				},
+			},
+			"severities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only include violations with the provided severities",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(reportDistributionSeverities, false),
+				},
+			},
+			"violations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: fmt.Sprintf("Only include the provided violations. Valid violations are: %s",
+					strings.Join(sortedReportDistributionViolations(), ", ")),
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(api.ReportDistributionViolations(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceLaceworkReportDistributionCreate(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	distribution := api.ReportDistribution{
+		ReportDefinitionGuid: d.Get("report_definition_guid").(string),
+		DistributionName:     d.Get("name").(string),
+		Data:                 getReportDistributionData(d),
+		AlertChannels:        castStringSlice(d.Get("alert_channels").(*schema.Set).List()),
+		Frequency:            d.Get("frequency").(string),
+	}
+
+	log.Printf("[INFO] Creating Report Distribution with data:\n%+v\n", distribution)
+	response, err := lacework.V2.ReportDistributions.Create(distribution)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(response.Data.ReportDistributionGuid)
+	log.Printf("[INFO] Created Report Distribution with guid %s\n", response.Data.ReportDistributionGuid)
+
+	return resourceLaceworkReportDistributionRead(d, meta)
+}
+
+func resourceLaceworkReportDistributionRead(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Reading Report Distribution with guid %s\n", d.Id())
+	response, err := lacework.V2.ReportDistributions.Get(d.Id())
+	if err != nil {
+		return resourceNotFound(d, err)
+	}
+
+	d.Set("name", response.Data.DistributionName)
+	d.Set("report_definition_guid", response.Data.ReportDefinitionGuid)
+	d.Set("frequency", response.Data.Frequency)
+	d.Set("alert_channels", response.Data.AlertChannels)
+	d.Set("resource_groups", response.Data.Data.ResourceGroups)
+	d.Set("integrations", flattenReportDistributionIntegrations(response.Data.Data.Integrations))
+	d.Set("severities", response.Data.Data.Severities)
+	d.Set("violations", response.Data.Data.Violations)
+
+	if len(response.Data.Data.Integrations) != 0 {
+		d.Set("scope", api.ReportDistributionScopeCloudIntegration.String())
+	} else {
+		d.Set("scope", api.ReportDistributionScopeResourceGroup.String())
+	}
+
+	log.Printf("[INFO] Read Report Distribution with guid %s\n", response.Data.ReportDistributionGuid)
+	return nil
+}
+
+func resourceLaceworkReportDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	update := api.ReportDistributionUpdate{
+		DistributionName: d.Get("name").(string),
+		Data:             getReportDistributionData(d),
+		AlertChannels:    castStringSlice(d.Get("alert_channels").(*schema.Set).List()),
+		Frequency:        d.Get("frequency").(string),
+	}
+
+	log.Printf("[INFO] Updating Report Distribution with data:\n%+v\n", update)
+	if _, err := lacework.V2.ReportDistributions.Update(d.Id(), update); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Updated Report Distribution with guid %s\n", d.Id())
+
+	return resourceLaceworkReportDistributionRead(d, meta)
+}
+
+func resourceLaceworkReportDistributionDelete(d *schema.ResourceData, meta interface{}) error {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Deleting Report Distribution with guid %s\n", d.Id())
+	err := lacework.V2.ReportDistributions.Delete(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleted Report Distribution with guid %s\n", d.Id())
+	return nil
+}
+
+// resourceLaceworkReportDistributionCustomizeDiff verifies that the resource groups or
+// the integrations match the scope of the report distribution
+func resourceLaceworkReportDistributionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	var (
+		scope          = d.Get("scope").(string)
+		resourceGroups = d.Get("resource_groups").(*schema.Set).Len()
+		integrations   = d.Get("integrations").(*schema.Set).Len()
+	)
+
+	switch scope {
+	case api.ReportDistributionScopeResourceGroup.String():
+		if integrations != 0 {
+			return fmt.Errorf("integrations can't be used with the '%s' scope, use resource_groups instead", scope)
+		}
+		if resourceGroups == 0 && d.NewValueKnown("resource_groups") {
+			return fmt.Errorf("at least one resource group is required with the '%s' scope", scope)
+		}
+	case api.ReportDistributionScopeCloudIntegration.String():
+		if resourceGroups != 0 {
+			return fmt.Errorf("resource_groups can't be used with the '%s' scope, use integrations instead", scope)
+		}
+		if integrations == 0 && d.NewValueKnown("integrations") {
+			return fmt.Errorf("at least one integration is required with the '%s' scope", scope)
+		}
+	}
+	return nil
+}
+
+func importLaceworkReportDistribution(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	lacework := meta.(*providerMeta).client
+
+	log.Printf("[INFO] Importing Lacework Report Distribution with guid: %s\n", d.Id())
+
+	response, err := lacework.V2.ReportDistributions.Get(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to import Lacework resource. Report Distribution with guid '%s' was not found",
+			d.Id(),
+		)
+	}
+	log.Printf("[INFO] Report Distribution found with guid: %s\n", response.Data.ReportDistributionGuid)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getReportDistributionData(d *schema.ResourceData) api.ReportDistributionData {
+	data := api.ReportDistributionData{
+		Severities:     castStringSlice(d.Get("severities").(*schema.Set).List()),
+		Violations:     castStringSlice(d.Get("violations").(*schema.Set).List()),
+		ResourceGroups: castStringSlice(d.Get("resource_groups").(*schema.Set).List()),
+		Integrations:   []api.ReportDistributionIntegration{},
+	}
+
+	for _, v := range d.Get("integrations").(*schema.Set).List() {
+		val := v.(map[string]interface{})
+		data.Integrations = append(data.Integrations, api.ReportDistributionIntegration{
+			AccountID:      val["account_id"].(string),
+			OrganizationID: val["organization_id"].(string),
+			ProjectID:      val["project_id"].(string),
+			TenantID:       val["tenant_id"].(string),
+			SubscriptionID: val["subscription_id"].(string),
+		})
+	}
+	return data
+}
+
+func flattenReportDistributionIntegrations(integrations []api.ReportDistributionIntegration) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(integrations))
+	for _, integration := range integrations {
+		list = append(list, map[string]interface{}{
+			"account_id":      integration.AccountID,
+			"organization_id": integration.OrganizationID,
+			"project_id":      integration.ProjectID,
+			"tenant_id":       integration.TenantID,
+			"subscription_id": integration.SubscriptionID,
+		})
+	}
+	return list
+}
+
+func sortedReportDistributionFrequencies() []string {
+	frequencies := api.ReportDistributionFrequencies()
+	sort.Strings(frequencies)
+	return frequencies
+}
+
+func sortedReportDistributionScopes() []string {
+	scopes := api.ReportDistributionScopes()
+	sort.Strings(scopes)
+	return scopes
+}
+
+func sortedReportDistributionViolations() []string {
+	violations := api.ReportDistributionViolations()
+	sort.Strings(violations)
+	return violations
+}
@@ -311,7 +311,7 @@ func resourceLaceworkReportRule() *schema.Resource {
 
 func resourceLaceworkReportRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework       = meta.(*api.Client)
+		lacework       = meta.(*providerMeta).client
 		resourceGroups = d.Get("resource_groups").(*schema.Set).List()
 		severities     = api.NewReportRuleSeverities(castAttributeToStringSlice(d, "severities"))
 		channels       = d.Get("email_alert_channels").(*schema.Set).List()
@@ -355,7 +355,7 @@ func resourceLaceworkReportRuleCreate(d *schema.ResourceData, meta interface{})
 
 func resourceLaceworkReportRuleRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 		response api.ReportRuleResponse
 	)
 
@@ -384,7 +384,7 @@ func resourceLaceworkReportRuleRead(d *schema.ResourceData, meta interface{}) er
 
 func resourceLaceworkReportRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework       = meta.(*api.Client)
+		lacework       = meta.(*providerMeta).client
 		resourceGroups = d.Get("resource_groups").(*schema.Set).List()
 		severities     = api.NewReportRuleSeverities(castAttributeToStringSlice(d, "severities"))
 		channels       = d.Get("email_alert_channels").(*schema.Set).List()
@@ -430,7 +430,7 @@ func resourceLaceworkReportRuleUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLaceworkReportRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting report rule with guid %s\n", d.Id())
 	err := lacework.V2.ReportRules.Delete(d.Id())
@@ -444,7 +444,13 @@ func resourceLaceworkReportRuleDelete(d *schema.ResourceData, meta interface{})
 
 func importLaceworkReportRule(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	var response api.ReportRuleResponse
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
+
+	guid, err := resolveReportRuleImportID(d.Id(), lacework)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(guid)
 
 	log.Printf("[INFO] Importing Lacework Report Rule with guid: %s\n", d.Id())
 
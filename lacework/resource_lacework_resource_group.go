@@ -2,8 +2,10 @@ package lacework
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/lacework/go-sdk/v2/api"
@@ -63,6 +65,10 @@ var groupOperator = &schema.Schema{
 	Required:    true,
 }
 
+// resourceGroupMaxBlockDepth is the number of nested group blocks supported by groupSchema,
+// deeper expressions can only be represented by the expression_json attribute
+const resourceGroupMaxBlockDepth = 3
+
 // Define global variable for groupSchema
 var groupSchema = &schema.Resource{
 	Schema: map[string]*schema.Schema{
@@ -112,6 +118,8 @@ func resourceLaceworkResourceGroup() *schema.Resource {
 			StateContext: importLaceworkResourceGroup,
 		},
 
+		CustomizeDiff: resourceLaceworkResourceGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -119,9 +127,10 @@ func resourceLaceworkResourceGroup() *schema.Resource {
 				Description: "The resource group name",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The type of the resource group",
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The type of the resource group. Valid types are: " +
+					strings.Join(sortedKeys(resourceGroupFieldCatalog), ", "),
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
@@ -135,10 +144,26 @@ func resourceLaceworkResourceGroup() *schema.Resource {
 				Description: "The description of the resource group",
 			},
 			"group": {
-				Type:        schema.TypeSet,
-				Required:    true,
-				Description: "The query used to fetch resources matching the filters and expression",
-				Elem:        groupSchema,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Description:  "The query used to fetch resources matching the filters and expression",
+				Elem:         groupSchema,
+				ExactlyOneOf: []string{"group", "expression_json"},
+			},
+			"expression_json": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The JSON representation of the query used to fetch resources matching the " +
+					"filters and expression, supports expressions of any depth",
+				ValidateFunc: validateResourceGroupExpressionJSON,
+				StateFunc: func(val interface{}) string {
+					normalized, err := normalizeResourceGroupExpressionJSON(val.(string))
+					if err != nil {
+						return val.(string)
+					}
+					return normalized
+				},
+				ExactlyOneOf: []string{"group", "expression_json"},
 			},
 			"id": {
 				Type:        schema.TypeString,
@@ -166,12 +191,18 @@ func resourceLaceworkResourceGroup() *schema.Resource {
 
 func importLaceworkResourceGroup(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData,
 	error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing resource group.")
 
+	guid, err := resolveResourceGroupImportID(d.Id(), lacework)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(guid)
+
 	var response api.ResourceGroupResponse
-	err := lacework.V2.ResourceGroups.Get(d.Id(), &response)
+	err = lacework.V2.ResourceGroups.Get(d.Id(), &response)
 	if err != nil {
 		return nil, err
 	}
@@ -211,10 +242,9 @@ func convertRgQueryToInterface(query *api.RGQuery) []interface{} {
 			})
 			result["group"] = append(result["group"].([]interface{}), nestedGroup...)
 		}
-
-		set = append(set, result)
 	}
 
+	set = append(set, result)
 	return set
 }
 
@@ -242,9 +272,12 @@ func addFilters(filters *schema.Set, query *api.RGQuery) []string {
 	return filterNames
 }
 
-func populateRgQuery(group *schema.Set, query *api.RGQuery, isTopLevelGroup bool) *api.RGChild {
-	groupDetails := group.List()
-	for _, v := range groupDetails {
+// populateRgQuery adds the filters of the provided groups to the query, the top level group
+// is the expression of the query and the nested groups are returned as children, all the
+// sibling groups are kept
+func populateRgQuery(group *schema.Set, query *api.RGQuery, isTopLevelGroup bool) []*api.RGChild {
+	var groups []*api.RGChild
+	for _, v := range group.List() {
 		val := v.(map[string]interface{})
 
 		operator := val["operator"].(string)
@@ -261,17 +294,15 @@ func populateRgQuery(group *schema.Set, query *api.RGQuery, isTopLevelGroup bool
 		}
 
 		if nestedGroup != nil {
-			nestedChild := populateRgQuery(nestedGroup, query, false)
-			if nestedChild != nil {
-				children = append(children, nestedChild)
-			}
+			children = append(children, populateRgQuery(nestedGroup, query, false)...)
 		}
 
 		if !isTopLevelGroup {
-			return &api.RGChild{
+			groups = append(groups, &api.RGChild{
 				Children: children,
 				Operator: operator,
-			}
+			})
+			continue
 		}
 
 		rgExpression := &api.RGExpression{
@@ -282,11 +313,145 @@ func populateRgQuery(group *schema.Set, query *api.RGQuery, isTopLevelGroup bool
 		query.Expression = rgExpression
 	}
 
+	return groups
+}
+
+// getResourceGroupQuery builds the resource group query either from the expression_json
+// attribute or from the nested group blocks
+func getResourceGroupQuery(d *schema.ResourceData) (*api.RGQuery, error) {
+	if expression, ok := d.GetOk("expression_json"); ok {
+		return parseResourceGroupExpressionJSON(expression.(string))
+	}
+
+	rgQuery := api.RGQuery{
+		Filters: map[string]*api.RGFilter{},
+	}
+
+	populateRgQuery(d.Get("group").(*schema.Set), &rgQuery, true)
+	return &rgQuery, nil
+}
+
+// setResourceGroupQuery stores the resource group query in the attribute used by the
+// configuration, falling back to expression_json when the query is deeper than the
+// supported group blocks, this is the case of imported resource groups
+func setResourceGroupQuery(d *schema.ResourceData, query *api.RGQuery) error {
+	_, useJSON := d.GetOk("expression_json")
+	if !useJSON && resourceGroupExpressionDepth(query.Expression) > resourceGroupMaxBlockDepth {
+		log.Printf("[INFO] Resource Group with guid %s is deeper than %d groups, using expression_json\n",
+			d.Id(), resourceGroupMaxBlockDepth)
+		useJSON = true
+	}
+
+	if !useJSON {
+		d.Set("expression_json", "")
+		d.Set("group", convertRgQueryToInterface(query))
+		return nil
+	}
+
+	expression, err := marshalResourceGroupExpressionJSON(query)
+	if err != nil {
+		return err
+	}
+
+	d.Set("group", nil)
+	d.Set("expression_json", expression)
 	return nil
 }
 
+// resourceGroupExpressionDepth returns the number of nested groups inside the provided expression
+func resourceGroupExpressionDepth(expression *api.RGExpression) int {
+	if expression == nil {
+		return 0
+	}
+	return resourceGroupChildrenDepth(expression.Children)
+}
+
+func resourceGroupChildrenDepth(children []*api.RGChild) int {
+	depth := 0
+	for _, child := range children {
+		if child.FilterName != "" {
+			continue
+		}
+		if d := 1 + resourceGroupChildrenDepth(child.Children); d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+func parseResourceGroupExpressionJSON(expression string) (*api.RGQuery, error) {
+	var query api.RGQuery
+	if err := json.Unmarshal([]byte(expression), &query); err != nil {
+		return nil, errors.Wrap(err, "unable to parse expression_json")
+	}
+
+	if query.Expression == nil {
+		return nil, errors.New("expression_json must define an expression")
+	}
+
+	if query.Filters == nil {
+		query.Filters = map[string]*api.RGFilter{}
+	}
+
+	if err := checkResourceGroupExpressionFilters(query.Expression.Children, query.Filters); err != nil {
+		return nil, err
+	}
+
+	return &query, nil
+}
+
+// checkResourceGroupExpressionFilters verifies that every filter referenced by the
+// expression is defined in the filters of the query
+func checkResourceGroupExpressionFilters(children []*api.RGChild, filters map[string]*api.RGFilter) error {
+	for _, child := range children {
+		if child.FilterName == "" {
+			if err := checkResourceGroupExpressionFilters(child.Children, filters); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, ok := filters[child.FilterName]; !ok {
+			return fmt.Errorf("expression_json references undefined filter '%s'", child.FilterName)
+		}
+	}
+	return nil
+}
+
+// marshalResourceGroupExpressionJSON returns the normalized JSON representation of the
+// provided query, map keys are sorted by the encoder and empty values are always encoded
+// the same way, so that semantically equal expressions produce the same string
+func marshalResourceGroupExpressionJSON(query *api.RGQuery) (string, error) {
+	for _, filter := range query.Filters {
+		if filter.Values == nil {
+			filter.Values = []string{}
+		}
+	}
+
+	expression, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	return string(expression), nil
+}
+
+func normalizeResourceGroupExpressionJSON(expression string) (string, error) {
+	query, err := parseResourceGroupExpressionJSON(expression)
+	if err != nil {
+		return "", err
+	}
+	return marshalResourceGroupExpressionJSON(query)
+}
+
+func validateResourceGroupExpressionJSON(value interface{}, key string) ([]string, []error) {
+	if _, err := parseResourceGroupExpressionJSON(value.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %s", key, err)}
+	}
+	return nil, nil
+}
+
 func resourceLaceworkResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	resourceType := d.Get("type").(string)
 	groupType, isValid := api.FindResourceGroupType(resourceType)
@@ -295,16 +460,15 @@ func resourceLaceworkResourceGroupCreate(d *schema.ResourceData, meta interface{
 		return errors.New("internal error")
 	}
 
-	rgQuery := api.RGQuery{
-		Filters: map[string]*api.RGFilter{},
+	rgQuery, err := getResourceGroupQuery(d)
+	if err != nil {
+		return err
 	}
 
-	populateRgQuery(d.Get("group").(*schema.Set), &rgQuery, true)
-
 	data := api.NewResourceGroup(d.Get("name").(string),
 		groupType,
 		d.Get("description").(string),
-		&rgQuery)
+		rgQuery)
 
 	// By default, enable the resource group
 	data.Enabled = 1
@@ -325,7 +489,9 @@ func resourceLaceworkResourceGroupCreate(d *schema.ResourceData, meta interface{
 	d.SetId(response.Data.ResourceGroupGuid)
 	d.Set("name", response.Data.Name)
 	d.Set("enabled", response.Data.Enabled == 1)
-	d.Set("group", convertRgQueryToInterface(response.Data.Query))
+	if err := setResourceGroupQuery(d, response.Data.Query); err != nil {
+		return err
+	}
 	d.Set("description", response.Data.Description)
 	d.Set("last_updated", response.Data.UpdatedTime.UTC().String())
 	d.Set("updated_by", response.Data.UpdatedBy)
@@ -338,7 +504,7 @@ func resourceLaceworkResourceGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceLaceworkResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading V2 Resource Group with guid %s\n", d.Id())
 	var response api.ResourceGroupResponse
@@ -359,7 +525,9 @@ func readResourceGroup(d *schema.ResourceData, resourceGroup *api.ResourceGroupR
 	d.SetId(resourceGroup.Data.ResourceGroupGuid)
 	d.Set("name", resourceGroup.Data.Name)
 	d.Set("enabled", resourceGroup.Data.Enabled == 1)
-	d.Set("group", convertRgQueryToInterface(resourceGroup.Data.Query))
+	if err := setResourceGroupQuery(d, resourceGroup.Data.Query); err != nil {
+		return err
+	}
 	d.Set("description", resourceGroup.Data.Description)
 	d.Set("last_updated", resourceGroup.Data.UpdatedTime.UTC().String())
 	d.Set("updated_by", resourceGroup.Data.UpdatedBy)
@@ -372,7 +540,7 @@ func readResourceGroup(d *schema.ResourceData, resourceGroup *api.ResourceGroupR
 }
 
 func resourceLaceworkResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	resourceType := d.Get("type").(string)
 	groupType, isValid := api.FindResourceGroupType(resourceType)
@@ -381,16 +549,15 @@ func resourceLaceworkResourceGroupUpdate(d *schema.ResourceData, meta interface{
 		return errors.New("internal error")
 	}
 
-	rgQuery := api.RGQuery{
-		Filters: map[string]*api.RGFilter{},
+	rgQuery, err := getResourceGroupQuery(d)
+	if err != nil {
+		return err
 	}
 
-	populateRgQuery(d.Get("group").(*schema.Set), &rgQuery, true)
-
 	data := api.NewResourceGroup(d.Get("name").(string),
 		groupType,
 		d.Get("description").(string),
-		&rgQuery)
+		rgQuery)
 
 	// By default, enable the resource group
 	data.Enabled = 1
@@ -413,7 +580,9 @@ func resourceLaceworkResourceGroupUpdate(d *schema.ResourceData, meta interface{
 	d.SetId(response.Data.ResourceGroupGuid)
 	d.Set("name", response.Data.Name)
 	d.Set("enabled", response.Data.Enabled == 1)
-	d.Set("group", convertRgQueryToInterface(response.Data.Query))
+	if err := setResourceGroupQuery(d, response.Data.Query); err != nil {
+		return err
+	}
 	d.Set("description", response.Data.Description)
 	d.Set("last_updated", response.Data.UpdatedTime.UTC().String())
 	d.Set("updated_by", response.Data.UpdatedBy)
@@ -426,7 +595,7 @@ func resourceLaceworkResourceGroupUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceLaceworkResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Resource Group with guid %s\n", d.Id())
 	err := lacework.V2.ResourceGroups.Delete(d.Id())
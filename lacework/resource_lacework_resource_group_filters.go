@@ -0,0 +1,218 @@
+package lacework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/pkg/errors"
+)
+
+// resourceGroupOperations are the operations of the resource group filters, every
+// filterable field supports the same operations
+var resourceGroupOperations = []string{
+	"EQUALS", "NOT_EQUALS",
+	"CONTAINS", "NOT_CONTAINS",
+	"STARTS_WITH", "NOT_STARTS_WITH",
+	"ENDS_WITH", "NOT_ENDS_WITH",
+	"INCLUDES", "NOT_INCLUDES",
+}
+
+// resourceGroupField describes a filterable field of a resource group type
+type resourceGroupField struct {
+	keyRequired bool
+}
+
+var (
+	resourceGroupValueField = resourceGroupField{}
+	resourceGroupKeyField   = resourceGroupField{keyRequired: true}
+)
+
+// resourceGroupFieldCatalog is the list of filterable fields per resource group type, see
+// https://docs.fortinet.com/document/lacework-forticnapp/latest/api-reference/690087/using-the-resource-groups-api#filterable-fields
+var resourceGroupFieldCatalog = map[string]map[string]resourceGroupField{
+	api.AwsResourceGroup.String(): {
+		"Account":         resourceGroupValueField,
+		"Organization ID": resourceGroupValueField,
+		"Region":          resourceGroupValueField,
+		"Resource Tag":    resourceGroupKeyField,
+	},
+	api.AzureResourceGroup.String(): {
+		"Tenant ID":         resourceGroupValueField,
+		"Tenant Name":       resourceGroupValueField,
+		"Subscription ID":   resourceGroupValueField,
+		"Subscription Name": resourceGroupValueField,
+		"Region":            resourceGroupValueField,
+		"Resource Tag":      resourceGroupKeyField,
+	},
+	api.ContainerResourceGroup.String(): {
+		"Image Repo":      resourceGroupValueField,
+		"Image Registry":  resourceGroupValueField,
+		"Image Tag":       resourceGroupValueField,
+		"Container Label": resourceGroupKeyField,
+	},
+	api.GcpResourceGroup.String(): {
+		"Organization ID":   resourceGroupValueField,
+		"Organization Name": resourceGroupValueField,
+		"Folder":            resourceGroupValueField,
+		"Project ID":        resourceGroupValueField,
+		"Project Name":      resourceGroupValueField,
+		"Region":            resourceGroupValueField,
+		"Resource Label":    resourceGroupKeyField,
+	},
+	api.MachineResourceGroup.String(): {
+		"Hostname":    resourceGroupValueField,
+		"External IP": resourceGroupValueField,
+		"Internal IP": resourceGroupValueField,
+		"Machine Tag": resourceGroupKeyField,
+	},
+	api.OciResourceGroup.String(): {
+		"Compartment ID":   resourceGroupValueField,
+		"Compartment Name": resourceGroupValueField,
+		"Region":           resourceGroupValueField,
+		"Resource Tag":     resourceGroupKeyField,
+	},
+	api.KubernetesResourceGroup.String(): {
+		"AWS Account":  resourceGroupValueField,
+		"AWS Region":   resourceGroupValueField,
+		"Cluster Name": resourceGroupValueField,
+		"Namespace":    resourceGroupValueField,
+	},
+}
+
+// resourceLaceworkResourceGroupCustomizeDiff validates at plan time the filters of the
+// resource group against the fields, operations and keys supported by its type
+func resourceLaceworkResourceGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rgType := d.Get("type").(string)
+	if rgType == "" {
+		// the type is not known until apply
+		return nil
+	}
+
+	var query *api.RGQuery
+	if expression := d.Get("expression_json").(string); expression != "" {
+		parsed, err := parseResourceGroupExpressionJSON(expression)
+		if err != nil {
+			// the error is reported by the attribute validation
+			return nil
+		}
+		query = parsed
+	} else {
+		query = &api.RGQuery{Filters: map[string]*api.RGFilter{}}
+		populateRgQuery(d.Get("group").(*schema.Set), query, true)
+	}
+
+	return validateResourceGroupQuery(rgType, query)
+}
+
+// validateResourceGroupQuery returns an error that describes every invalid filter of the
+// provided query, including the path of nested groups where the filter is used
+func validateResourceGroupQuery(rgType string, query *api.RGQuery) error {
+	fields, ok := resourceGroupFieldCatalog[rgType]
+	if !ok {
+		return fmt.Errorf("invalid resource group type '%s', valid types are: %s",
+			rgType, strings.Join(sortedKeys(resourceGroupFieldCatalog), ", "))
+	}
+
+	var (
+		messages []string
+		visited  = map[string]bool{}
+	)
+
+	var walk func(children []*api.RGChild, path string)
+	walk = func(children []*api.RGChild, path string) {
+		for _, child := range children {
+			if child.FilterName == "" {
+				walk(child.Children, fmt.Sprintf("%s > group (%s)", path, child.Operator))
+				continue
+			}
+
+			visited[child.FilterName] = true
+			if filter, ok := query.Filters[child.FilterName]; ok {
+				if err := validateResourceGroupFilter(rgType, fields, filter); err != nil {
+					messages = append(messages,
+						fmt.Sprintf("filter '%s' in %s: %s", child.FilterName, path, err))
+				}
+			}
+		}
+	}
+
+	if query.Expression != nil {
+		walk(query.Expression.Children, fmt.Sprintf("group (%s)", query.Expression.Operator))
+	}
+
+	// filters that are not referenced by the expression are still sent to the API
+	for _, name := range sortedKeys(query.Filters) {
+		if visited[name] {
+			continue
+		}
+		if err := validateResourceGroupFilter(rgType, fields, query.Filters[name]); err != nil {
+			messages = append(messages, fmt.Sprintf("filter '%s': %s", name, err))
+		}
+	}
+
+	if len(messages) != 0 {
+		return fmt.Errorf("invalid %s resource group filters:\n  - %s", rgType, strings.Join(messages, "\n  - "))
+	}
+	return nil
+}
+
+func validateResourceGroupFilter(rgType string, fields map[string]resourceGroupField, filter *api.RGFilter) error {
+	if filter.Field == "" {
+		// the field is not known until apply
+		return nil
+	}
+
+	field, ok := fields[filter.Field]
+	if !ok {
+		msg := fmt.Sprintf("field '%s' is not supported by %s resource groups, valid fields are: %s",
+			filter.Field, rgType, strings.Join(sortedKeys(fields), ", "))
+		if suggestion := suggestResourceGroupField(fields, filter.Field); suggestion != "" {
+			msg = fmt.Sprintf("%s (did you mean '%s'?)", msg, suggestion)
+		}
+		return errors.New(msg)
+	}
+
+	if filter.Operation != "" && !ContainsStr(resourceGroupOperations, filter.Operation) {
+		return fmt.Errorf("operation '%s' is not supported, valid operations are: %s",
+			filter.Operation, strings.Join(resourceGroupOperations, ", "))
+	}
+
+	if field.keyRequired && filter.Key == "" {
+		return fmt.Errorf("field '%s' requires a key", filter.Field)
+	}
+
+	if !field.keyRequired && filter.Key != "" {
+		return fmt.Errorf("field '%s' does not support a key", filter.Field)
+	}
+
+	return nil
+}
+
+// suggestResourceGroupField returns the supported field that matches the provided
+// one when ignoring case, spaces and a trailing plural, i.e. resourceTags => Resource Tag
+func suggestResourceGroupField(fields map[string]resourceGroupField, field string) string {
+	normalize := func(s string) string {
+		s = strings.ToLower(strings.NewReplacer(" ", "", "_", "", "-", "").Replace(s))
+		return strings.TrimSuffix(s, "s")
+	}
+
+	for name := range fields {
+		if normalize(name) == normalize(field) {
+			return name
+		}
+	}
+	return ""
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
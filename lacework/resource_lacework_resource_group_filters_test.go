@@ -0,0 +1,56 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateResourceGroupQuery(t *testing.T) {
+	query := &api.RGQuery{
+		Filters: map[string]*api.RGFilter{
+			"region": {Field: "Region", Operation: "EQUALS", Values: []string{"us-east-1"}},
+			"tag":    {Field: "Resource Tag", Operation: "EQUALS", Key: "env", Values: []string{"prod"}},
+		},
+		Expression: &api.RGExpression{
+			Operator: "AND",
+			Children: []*api.RGChild{
+				{FilterName: "region"},
+				{Operator: "OR", Children: []*api.RGChild{{FilterName: "tag"}}},
+			},
+		},
+	}
+
+	assert.NoError(t, validateResourceGroupQuery("AWS", query))
+
+	err := validateResourceGroupQuery("AWSS", query)
+	assert.ErrorContains(t, err, "invalid resource group type 'AWSS'")
+
+	query.Filters["tag"].Field = "resourceTags"
+	err = validateResourceGroupQuery("AWS", query)
+	assert.ErrorContains(t, err, "filter 'tag' in group (AND) > group (OR)")
+	assert.ErrorContains(t, err, "did you mean 'Resource Tag'?")
+}
+
+func TestValidateResourceGroupFilter(t *testing.T) {
+	fields := resourceGroupFieldCatalog["GCP"]
+
+	assert.NoError(t, validateResourceGroupFilter("GCP", fields,
+		&api.RGFilter{Field: "Resource Label", Operation: "EQUALS", Key: "env"}))
+	// unknown values are validated during apply
+	assert.NoError(t, validateResourceGroupFilter("GCP", fields, &api.RGFilter{}))
+
+	assert.ErrorContains(t, validateResourceGroupFilter("GCP", fields,
+		&api.RGFilter{Field: "Project ID", Operation: "GREATER_THAN"}),
+		"operation 'GREATER_THAN' is not supported, valid operations are: EQUALS, NOT_EQUALS")
+	assert.ErrorContains(t, validateResourceGroupFilter("GCP", fields,
+		&api.RGFilter{Field: "Resource Label", Operation: "EQUALS"}),
+		"field 'Resource Label' requires a key")
+	assert.ErrorContains(t, validateResourceGroupFilter("GCP", fields,
+		&api.RGFilter{Field: "Region", Operation: "EQUALS", Key: "env"}),
+		"field 'Region' does not support a key")
+	assert.ErrorContains(t, validateResourceGroupFilter("GCP", fields,
+		&api.RGFilter{Field: "Account", Operation: "EQUALS"}),
+		"field 'Account' is not supported by GCP resource groups")
+}
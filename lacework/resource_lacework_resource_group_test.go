@@ -0,0 +1,88 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeResourceGroupExpressionJSON(t *testing.T) {
+	var (
+		compact = `{"filters":{"filter1":{"field":"Region","operation":"EQUALS","values":["us-east-1"]}},` +
+			`"expression":{"operator":"AND","children":[{"filterName":"filter1"}]}}`
+		pretty = `{
+  "expression": {
+    "children": [{ "filterName": "filter1" }],
+    "operator": "AND"
+  },
+  "filters": {
+    "filter1": { "values": ["us-east-1"], "operation": "EQUALS", "field": "Region" }
+  }
+}`
+	)
+
+	a, err := normalizeResourceGroupExpressionJSON(compact)
+	require.NoError(t, err)
+	b, err := normalizeResourceGroupExpressionJSON(pretty)
+	require.NoError(t, err)
+	assert.Equal(t, a, b)
+}
+
+func TestParseResourceGroupExpressionJSONErrors(t *testing.T) {
+	_, err := parseResourceGroupExpressionJSON(`not json`)
+	assert.ErrorContains(t, err, "unable to parse expression_json")
+
+	_, err = parseResourceGroupExpressionJSON(`{"filters":{}}`)
+	assert.ErrorContains(t, err, "must define an expression")
+
+	_, err = parseResourceGroupExpressionJSON(
+		`{"filters":{},"expression":{"operator":"OR","children":[{"operator":"AND","children":[{"filterName":"f1"}]}]}}`)
+	assert.ErrorContains(t, err, "undefined filter 'f1'")
+}
+
+func TestResourceGroupExpressionDepth(t *testing.T) {
+	nested := func(child *api.RGChild) *api.RGChild {
+		return &api.RGChild{Operator: "AND", Children: []*api.RGChild{{FilterName: "f1"}, child}}
+	}
+
+	leaf := &api.RGChild{Operator: "OR", Children: []*api.RGChild{{FilterName: "f1"}}}
+
+	assert.Equal(t, 0, resourceGroupExpressionDepth(nil))
+	assert.Equal(t, 0, resourceGroupExpressionDepth(
+		&api.RGExpression{Operator: "AND", Children: []*api.RGChild{{FilterName: "f1"}}}))
+	assert.Equal(t, 1, resourceGroupExpressionDepth(
+		&api.RGExpression{Operator: "AND", Children: []*api.RGChild{leaf}}))
+	assert.Equal(t, 4, resourceGroupExpressionDepth(
+		&api.RGExpression{Operator: "AND", Children: []*api.RGChild{nested(nested(nested(leaf)))}}))
+}
+
+func TestResourceGroupQuerySiblingGroups(t *testing.T) {
+	query := &api.RGQuery{
+		Filters: map[string]*api.RGFilter{
+			"filter1": {Field: "Region", Operation: "EQUALS", Values: []string{"us-east-1"}},
+			"filter2": {Field: "Account", Operation: "EQUALS", Values: []string{"123456789012"}},
+			"filter3": {Field: "Account", Operation: "EQUALS", Values: []string{"210987654321"}},
+		},
+		Expression: &api.RGExpression{
+			Operator: "AND",
+			Children: []*api.RGChild{
+				{FilterName: "filter1"},
+				{Operator: "OR", Children: []*api.RGChild{{FilterName: "filter2"}}},
+				{Operator: "AND", Children: []*api.RGChild{{FilterName: "filter3"}}},
+			},
+		},
+	}
+
+	d := resourceLaceworkResourceGroup().TestResourceData()
+	require.NoError(t, setResourceGroupQuery(d, query))
+	assert.Equal(t, "", d.Get("expression_json"))
+
+	// both sibling groups are kept in the group blocks and in the query built from them
+	actual, err := getResourceGroupQuery(d)
+	require.NoError(t, err)
+	assert.Equal(t, query.Filters, actual.Filters)
+	assert.Equal(t, "AND", actual.Expression.Operator)
+	assert.ElementsMatch(t, query.Expression.Children, actual.Expression.Children)
+}
@@ -125,7 +125,7 @@ func resourceLaceworkTeamMember() *schema.Resource {
 }
 
 func resourceLaceworkTeamMemberCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	if lacework.OrgAccess() {
 		return laceworkTeamMemberCreateOrg(d, meta)
@@ -135,7 +135,7 @@ func resourceLaceworkTeamMemberCreate(d *schema.ResourceData, meta interface{})
 }
 
 func laceworkTeamMemberCreateOrg(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	tmOrg := api.NewTeamMemberOrg(d.Get("email").(string),
 		api.TeamMemberProps{
@@ -218,7 +218,7 @@ func validateOrgTeamMember(m *api.TeamMemberOrg) error {
 }
 
 func laceworkTeamMemberCreate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	if _, ok := d.GetOk("organization.0"); ok {
 		msg := `
@@ -265,7 +265,7 @@ Refer to the resource documentation for more information:
 }
 
 func resourceLaceworkTeamMemberRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	if lacework.OrgAccess() {
 		return laceworkTeamMemberReadOrg(d, meta)
@@ -275,7 +275,7 @@ func resourceLaceworkTeamMemberRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func laceworkTeamMemberReadOrg(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	var (
 		response api.TeamMemberResponse
@@ -359,7 +359,7 @@ func laceworkTeamMemberReadOrg(d *schema.ResourceData, meta interface{}) error {
 }
 
 func laceworkTeamMemberRead(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Reading team member with user guid %s\n", d.Id())
 
@@ -385,7 +385,7 @@ func laceworkTeamMemberRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLaceworkTeamMemberUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	if lacework.OrgAccess() {
 		return laceworkTeamMemberUpdateOrg(d, meta)
@@ -395,7 +395,7 @@ func resourceLaceworkTeamMemberUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func laceworkTeamMemberUpdateOrg(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	tmOrg := api.NewTeamMemberOrg(d.Get("email").(string),
 		api.TeamMemberProps{
@@ -460,7 +460,7 @@ updated successfully and report this issue to support@lacework.net
 }
 
 func laceworkTeamMemberUpdate(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	if _, ok := d.GetOk("organization.0"); ok {
 		msg := `
@@ -507,7 +507,7 @@ Refer to the resource documentation for more information:
 }
 
 func resourceLaceworkTeamMemberDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	if lacework.OrgAccess() {
 		return laceworkTeamMemberDeleteOrg(d, meta)
@@ -517,7 +517,7 @@ func resourceLaceworkTeamMemberDelete(d *schema.ResourceData, meta interface{})
 }
 
 func laceworkTeamMemberDeleteOrg(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting org team member with the user guid: %s\n", d.Id())
 	err := lacework.V2.TeamMembers.DeleteOrg(d.Id())
@@ -536,7 +536,7 @@ func laceworkTeamMemberDeleteOrg(d *schema.ResourceData, meta interface{}) error
 }
 
 func laceworkTeamMemberDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting team member with the user guid: %s\n", d.Id())
 	err := lacework.V2.TeamMembers.Delete(d.Id())
@@ -549,7 +549,7 @@ func laceworkTeamMemberDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func importLaceworkTeamMember(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	// we have two ways to import a team member, the first one is mostly for
 	// org team members where the user provides an email
@@ -229,7 +229,7 @@ func resourceLaceworkVulnerabilityExceptionContainer() *schema.Resource {
 
 func resourceLaceworkVulnerabilityExceptionContainerCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		severities = castAttributeToStringSlice(d, "vulnerability_criteria.0.severities")
 		packages   = castAttributeToArrayOfCustomKeyValueMap(d, "vulnerability_criteria.0.package", "name", "version")
 		fixable    *bool
@@ -297,7 +297,7 @@ func resourceLaceworkVulnerabilityExceptionContainerCreate(d *schema.ResourceDat
 
 func resourceLaceworkVulnerabilityExceptionContainerRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading vulnerability exception with guid %s\n", d.Id())
@@ -344,7 +344,7 @@ func resourceLaceworkVulnerabilityExceptionContainerRead(d *schema.ResourceData,
 
 func resourceLaceworkVulnerabilityExceptionContainerUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		severities = castAttributeToStringSlice(d, "vulnerability_criteria.0.severities")
 		packages   = castAttributeToArrayOfCustomKeyValueMap(d, "vulnerability_criteria.0.package", "name", "version")
 		fixable    *bool
@@ -410,7 +410,7 @@ func resourceLaceworkVulnerabilityExceptionContainerUpdate(d *schema.ResourceDat
 }
 
 func resourceLaceworkVulnerabilityExceptionContainerDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting vulnerability exception with guid %s\n", d.Id())
 	err := lacework.V2.VulnerabilityExceptions.Delete(d.Id())
@@ -423,7 +423,7 @@ func resourceLaceworkVulnerabilityExceptionContainerDelete(d *schema.ResourceDat
 }
 
 func importLaceworkVulnerabilityContainerException(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Vulnerability Exception with guid: %s\n", d.Id())
 
@@ -219,7 +219,7 @@ func resourceLaceworkVulnerabilityExceptionHost() *schema.Resource {
 
 func resourceLaceworkVulnerabilityExceptionHostCreate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		severities = castAttributeToStringSlice(d, "vulnerability_criteria.0.severities")
 		fixable    *bool
 		packages   = castAttributeToArrayOfCustomKeyValueMap(d, "vulnerability_criteria.0.package", "name", "version")
@@ -286,7 +286,7 @@ func resourceLaceworkVulnerabilityExceptionHostCreate(d *schema.ResourceData, me
 
 func resourceLaceworkVulnerabilityExceptionHostRead(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework = meta.(*api.Client)
+		lacework = meta.(*providerMeta).client
 	)
 
 	log.Printf("[INFO] Reading vulnerability exception with guid %s\n", d.Id())
@@ -334,7 +334,7 @@ func resourceLaceworkVulnerabilityExceptionHostRead(d *schema.ResourceData, meta
 
 func resourceLaceworkVulnerabilityExceptionHostUpdate(d *schema.ResourceData, meta interface{}) error {
 	var (
-		lacework   = meta.(*api.Client)
+		lacework   = meta.(*providerMeta).client
 		fixable    *bool
 		severities = castAttributeToStringSlice(d, "vulnerability_criteria.0.severities")
 		packages   = castAttributeToArrayOfCustomKeyValueMap(d, "vulnerability_criteria.0.package", "name", "version")
@@ -399,7 +399,7 @@ func resourceLaceworkVulnerabilityExceptionHostUpdate(d *schema.ResourceData, me
 }
 
 func resourceLaceworkVulnerabilityExceptionHostDelete(d *schema.ResourceData, meta interface{}) error {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting vulnerability exception with guid %s\n", d.Id())
 	err := lacework.V2.VulnerabilityExceptions.Delete(d.Id())
@@ -412,7 +412,7 @@ func resourceLaceworkVulnerabilityExceptionHostDelete(d *schema.ResourceData, me
 }
 
 func importLaceworkVulnerabilityHostException(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	lacework := meta.(*api.Client)
+	lacework := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Importing Lacework Vulnerability Exception with guid: %s\n", d.Id())
 
@@ -43,6 +43,25 @@ func StringDoesNotHavePrefix(chars string) schema.SchemaValidateDiagFunc {
 	})
 }
 
+// StringHasPrefix returns a SchemaValidateFunc which validates that the
+// provided value starts with the provided prefix.
+func StringHasPrefix(prefix string) schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return warnings, errors
+		}
+
+		if !strings.HasPrefix(v, prefix) {
+			errors = append(errors, fmt.Errorf("expected value of %s to start with %q, got %v", k, prefix, i))
+			return warnings, errors
+		}
+
+		return warnings, errors
+	})
+}
+
 // ValidateTimeFormat returns a SchemaValidateFunc which validates that the
 // value is in the timeformat supplied.
 func ValidateTimeFormat(timeFormat string) schema.SchemaValidateDiagFunc {
@@ -0,0 +1,99 @@
+package lacework
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// searchExpressions are the expressions supported by the filters of the search endpoints
+var searchExpressions = []string{
+	"eq", "ne", "in", "not_in", "like", "ilike", "not_like", "not_ilike",
+	"rlike", "not_rlike", "gt", "ge", "lt", "le", "between",
+}
+
+// searchFilterSchema returns the schema of the filters of the search endpoints
+func searchFilterSchema(description, fieldDescription string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: fieldDescription,
+				},
+				"expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The expression to compare the field with the value or values",
+					ValidateFunc: validation.StringInSlice(searchExpressions, false),
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The value to compare the field with",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The values to compare the field with, used by the in, not_in and between expressions",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandSearchFilters builds the filters of the search endpoints
+func expandSearchFilters(d *schema.ResourceData) ([]api.Filter, error) {
+	var filters []api.Filter
+	for i, f := range d.Get("filter").([]interface{}) {
+		filter := f.(map[string]interface{})
+		var (
+			expression = filter["expression"].(string)
+			value      = filter["value"].(string)
+			values     = castStringSlice(filter["values"].([]interface{}))
+		)
+
+		switch expression {
+		case "in", "not_in":
+			if len(values) == 0 {
+				return nil, fmt.Errorf("filter.%d: the %s expression requires values", i, expression)
+			}
+		case "between":
+			if len(values) != 2 {
+				return nil, fmt.Errorf("filter.%d: the between expression requires exactly two values", i)
+			}
+		default:
+			if value == "" || len(values) != 0 {
+				return nil, fmt.Errorf("filter.%d: the %s expression requires a value and no values", i, expression)
+			}
+		}
+
+		filters = append(filters, api.Filter{
+			Field:      filter["field"].(string),
+			Expression: expression,
+			Value:      value,
+			Values:     values,
+		})
+	}
+	return filters, nil
+}
+
+// formatSearchTime formats the times returned by the search endpoints, the times that
+// were not returned are empty
+func formatSearchTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,44 @@
+package lacework
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// secretVersionSchema is the schema of the '<secret>_version' attribute paired with the
+// write-only secrets of the integrations. Write-only secrets are never stored in the state,
+// so changes to a secret can't be detected, incrementing its version triggers an update that
+// sends the configured secret to the Lacework platform (i.e. after a rotation)
+func secretVersionSchema(secret string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Description: fmt.Sprintf(
+			"The version of the %s, increment it to send the %s to Lacework when it changes", secret, secret,
+		),
+	}
+}
+
+// getWriteOnlyString returns the string configured for the write-only attribute
+// with the provided key (i.e. 'credentials.0.private_key'), write-only attributes
+// are only available in the configuration, d.Get() always returns an empty string
+func getWriteOnlyString(d *schema.ResourceData, key string) string {
+	var path cty.Path
+	for _, step := range strings.Split(key, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+
+	value, diags := d.GetRawConfigAt(path)
+	if diags.HasError() || !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+	return value.AsString()
+}
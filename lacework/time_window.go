@@ -0,0 +1,54 @@
+package lacework
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lacework/go-sdk/v2/lwtime"
+)
+
+// parseTimeWindowValue parses either an absolute time in RFC3339 format or a
+// relative time specifier like '-24h', '-7d@d' or 'now'
+func parseTimeWindowValue(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := lwtime.ParseRelative(value)
+	if err != nil {
+		return t, fmt.Errorf("'%s' is neither an RFC3339 time nor a relative time specifier", value)
+	}
+	return t, nil
+}
+
+// getTimeWindow returns the start and end times of the time window defined by
+// the provided attributes, the start time must be before the end time
+func getTimeWindow(d *schema.ResourceData, startAttr, endAttr string) (time.Time, time.Time, error) {
+	start, err := parseTimeWindowValue(d.Get(startAttr).(string))
+	if err != nil {
+		return start, start, fmt.Errorf("invalid %s: %s", startAttr, err)
+	}
+
+	end, err := parseTimeWindowValue(d.Get(endAttr).(string))
+	if err != nil {
+		return start, end, fmt.Errorf("invalid %s: %s", endAttr, err)
+	}
+
+	if !start.Before(end) {
+		return start, end, fmt.Errorf("%s must be before %s", startAttr, endAttr)
+	}
+	return start, end, nil
+}
+
+// ValidTimeWindowValue returns a SchemaValidateDiagFunc which validates that the
+// value is either an RFC3339 time or a relative time specifier
+func ValidTimeWindowValue() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (warnings []string, errors []error) {
+		if _, err := parseTimeWindowValue(i.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("%s: %s", k, err))
+		}
+		return
+	})
+}
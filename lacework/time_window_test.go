@@ -0,0 +1,22 @@
+package lacework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeWindowValue(t *testing.T) {
+	absolute, err := parseTimeWindowValue("2026-01-02T03:04:05Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), absolute)
+
+	relative, err := parseTimeWindowValue("-24h")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-24*time.Hour), relative, time.Minute)
+
+	_, err = parseTimeWindowValue("yesterday-ish")
+	assert.ErrorContains(t, err, "neither an RFC3339 time nor a relative time specifier")
+}
@@ -0,0 +1,108 @@
+package lacework
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lacework/go-sdk/v2/api"
+)
+
+// vulnerabilitySearchSchema returns the schema shared by the host and container vulnerability
+// data sources, merged with the schema of the vulnerabilities returned by the data source
+func vulnerabilitySearchSchema(vulnerability map[string]*schema.Schema) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "-24h",
+			Description: "The start of the time window, either an RFC3339 time or a relative " +
+				"time specifier like -24h or -7d@d",
+			ValidateDiagFunc: ValidTimeWindowValue(),
+		},
+		"end_time": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "now",
+			Description: "The end of the time window, either an RFC3339 time or a relative " +
+				"time specifier like now or -1h",
+			ValidateDiagFunc: ValidTimeWindowValue(),
+		},
+		"filter": searchFilterSchema(
+			"The filters that the vulnerabilities must match, all filters must match",
+			"The field to filter on, i.e. severity or vulnId",
+		),
+		"returns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Description: "The fields to return, all fields are returned when not set. " +
+				"The fields not returned are empty in the vulnerabilities",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"vuln_ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The sorted and unique ids of the vulnerabilities that matched the filters",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"vulnerabilities_json": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The JSON encoded vulnerabilities that matched the filters, as returned by the Lacework API",
+		},
+		"vulnerabilities": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The vulnerabilities that matched the filters",
+			Elem: &schema.Resource{
+				Schema: vulnerability,
+			},
+		},
+	}
+}
+
+// expandVulnerabilitySearchFilter builds the search filter of the vulnerability data sources
+func expandVulnerabilitySearchFilter(d *schema.ResourceData) (api.SearchFilter, error) {
+	start, end, err := getTimeWindow(d, "start_time", "end_time")
+	if err != nil {
+		return api.SearchFilter{}, err
+	}
+
+	search := api.SearchFilter{
+		TimeFilter: &api.TimeFilter{StartTime: &start, EndTime: &end},
+		Returns:    castStringSlice(d.Get("returns").([]interface{})),
+	}
+
+	search.Filters, err = expandSearchFilters(d)
+	return search, err
+}
+
+// setVulnerabilitySearchResults sets the computed attributes shared by the vulnerability data sources
+func setVulnerabilitySearchResults(d *schema.ResourceData, data interface{}, vulnIDs []string,
+	vulnerabilities []map[string]interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to encode the vulnerabilities: %s", err)
+	}
+
+	unique := make([]string, 0, len(vulnIDs))
+	seen := map[string]bool{}
+	for _, id := range vulnIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	sort.Strings(unique)
+
+	d.Set("vuln_ids", unique)
+	d.Set("vulnerabilities_json", string(raw))
+	d.Set("vulnerabilities", vulnerabilities)
+	return nil
+}
@@ -0,0 +1,73 @@
+package lacework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/lacework/go-sdk/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandVulnerabilitySearchFilter(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceLaceworkHostVulnerabilities().Schema, map[string]interface{}{
+		"start_time": "2026-01-01T00:00:00Z",
+		"end_time":   "2026-01-02T00:00:00Z",
+		"returns":    []interface{}{"vulnId", "mid"},
+		"filter": []interface{}{
+			map[string]interface{}{"field": "severity", "expression": "eq", "value": "Critical"},
+			map[string]interface{}{"field": "status", "expression": "in", "values": []interface{}{"New", "Active"}},
+		},
+	})
+
+	filter, err := expandVulnerabilitySearchFilter(d)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2026-01-01T00:00:00Z", filter.TimeFilter.StartTime.Format("2006-01-02T15:04:05Z07:00"))
+		assert.Equal(t, "2026-01-02T00:00:00Z", filter.TimeFilter.EndTime.Format("2006-01-02T15:04:05Z07:00"))
+		assert.Equal(t, []string{"vulnId", "mid"}, filter.Returns)
+		assert.Equal(t, []api.Filter{
+			{Field: "severity", Expression: "eq", Value: "Critical", Values: []string{}},
+			{Field: "status", Expression: "in", Values: []string{"New", "Active"}},
+		}, filter.Filters)
+	}
+}
+
+func TestExpandVulnerabilitySearchFilterInvalid(t *testing.T) {
+	cases := map[string]struct {
+		filter map[string]interface{}
+		err    string
+	}{
+		"in without values": {
+			map[string]interface{}{"field": "status", "expression": "in", "value": "New"},
+			"filter.0: the in expression requires values",
+		},
+		"between with one value": {
+			map[string]interface{}{"field": "startTime", "expression": "between", "values": []interface{}{"a"}},
+			"filter.0: the between expression requires exactly two values",
+		},
+		"eq without value": {
+			map[string]interface{}{"field": "severity", "expression": "eq"},
+			"filter.0: the eq expression requires a value and no values",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceLaceworkContainerVulnerabilities().Schema, map[string]interface{}{
+				"filter": []interface{}{c.filter},
+			})
+			_, err := expandVulnerabilitySearchFilter(d)
+			assert.EqualError(t, err, c.err)
+		})
+	}
+}
+
+func TestSetVulnerabilitySearchResults(t *testing.T) {
+	d := dataSourceLaceworkHostVulnerabilities().TestResourceData()
+	err := setVulnerabilitySearchResults(d, []map[string]string{{"vulnId": "CVE-2"}},
+		[]string{"CVE-2", "CVE-1", "CVE-2", ""}, []map[string]interface{}{{"vuln_id": "CVE-2", "mid": 42}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"CVE-1", "CVE-2"}, d.Get("vuln_ids"))
+		assert.Equal(t, `[{"vulnId":"CVE-2"}]`, d.Get("vulnerabilities_json"))
+		assert.Equal(t, 42, d.Get("vulnerabilities.0.mid"))
+	}
+}
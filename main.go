@@ -1,12 +1,80 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 
 	"github.com/lacework/terraform-provider-lacework/lacework"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: lacework.Provider})
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// the ephemeral resources are implemented with the terraform-plugin-framework,
+	// both providers are served together as a single provider
+	ctx := context.Background()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		lacework.Provider().GRPCProvider,
+		providerserver.NewProtocol5(lacework.FrameworkProvider()),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := tf5server.Serve("registry.terraform.io/lacework/lacework", muxServer.ProviderServer); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate writes the Terraform configuration of the resources of an existing Lacework
+// account, with import blocks to bring them under management with Terraform 1.5+
+func generate(args []string) error {
+	var (
+		opts  lacework.GenerateOptions
+		types string
+		flags = flag.NewFlagSet("generate", flag.ContinueOnError)
+	)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s generate [options]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Generates the Terraform configuration of the resources of a Lacework account.")
+		fmt.Fprintln(flags.Output(), "The provider settings not provided are read from the LW_* environment")
+		fmt.Fprintln(flags.Output(), "variables and the Lacework configuration file, like the provider does.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&opts.Dir, "out", ".", "directory where the .tf files are written")
+	flags.BoolVar(&opts.Force, "force", false, "overwrite the .tf files that already exist")
+	flags.StringVar(&types, "types", "", "comma separated list of resource types to generate (default all)")
+	flags.StringVar(&opts.Profile, "profile", "", "profile of the Lacework configuration file")
+	flags.StringVar(&opts.Account, "account", "", "Lacework account")
+	flags.StringVar(&opts.Subaccount, "subaccount", "", "Lacework sub-account")
+	flags.BoolVar(&opts.Organization, "organization", false, "generate the organization level resources")
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	for _, resourceType := range strings.Split(types, ",") {
+		if resourceType = strings.TrimSpace(resourceType); resourceType != "" {
+			opts.ResourceTypes = append(opts.ResourceTypes, resourceType)
+		}
+	}
+
+	return lacework.Generate(context.Background(), opts)
 }